@@ -13,13 +13,19 @@ import (
 
 var commands = []*cli.Command{
 	new(action.Build).Cmd(),
+	new(action.Lock).Cmd(),
 	new(action.Diff).Cmd(),
+	new(action.Drift).Cmd(),
 	new(action.Up).Cmd(),
 	new(action.List).Cmd(),
 	new(action.Rollback).Cmd(),
 	new(action.Status).Cmd(),
+	new(action.History).Cmd(),
+	new(action.Test).Cmd(),
 	new(action.Down).Cmd(),
 	new(action.Validate).Cmd(),
+	new(action.Show).Cmd(),
+	new(action.Get).Cmd(),
 	new(action.Yml).Cmd(),
 	version(),
 	completion(),
@@ -19,6 +19,8 @@ type Build struct {
 	matchAll bool
 	autoYml  bool
 
+	mergeChartValues bool
+
 	// diffLive *DiffLive
 	// diffLocal *DiffLocalPlan
 }
@@ -41,7 +43,7 @@ func (i *Build) Run() (err error) {
 	}
 
 	newPlan := plan.New(i.plandir)
-	err = newPlan.Build(i.yml.file, i.normalizeTags(), i.matchAll, i.yml.templater)
+	err = newPlan.Build(i.yml.file, i.normalizeTags(), i.matchAll, i.yml.templater, i.mergeChartValues)
 	if err != nil {
 		return err
 	}
@@ -58,12 +60,23 @@ func (i *Build) Run() (err error) {
 				return err
 			}
 
-			newPlan.DiffPlan(oldPlan, i.diff.ShowSecret, i.diff.Wide)
+			report := newPlan.DiffPlan(oldPlan, i.diff.ShowSecret, i.diff.Wide)
+			if err := i.diff.render(report); err != nil {
+				return err
+			}
+			i.diff.renderSummary(report)
 		}
 
 	case DiffModeLive:
 		log.Info("🆚 Diff manifests in the kubernetes cluster")
-		newPlan.DiffLive(i.diff.ShowSecret, i.diff.Wide)
+		report, err := newPlan.DiffLive(i.diff.ShowSecret, i.diff.ShowDecrypted, i.diff.Wide, 0)
+		if err != nil {
+			return err
+		}
+
+		if err := i.diff.render(report); err != nil {
+			return err
+		}
 	default:
 		log.Warnf("I dont know what is %q. I am skiping diff.", i.diffMode)
 	}
@@ -103,6 +116,13 @@ func (i *Build) flags() []cli.Flag {
 		flagMatchAllTags(&i.matchAll),
 		flagDiffMode(&i.diffMode),
 
+		&cli.BoolFlag{
+			Name:        "merge-chart-values",
+			Usage:       "Merge default values of charts into merged values of releases",
+			Value:       false,
+			EnvVars:     []string{"HELMWAVE_MERGE_CHART_VALUES"},
+			Destination: &i.mergeChartValues,
+		},
 		&cli.BoolFlag{
 			Name:        "yml",
 			Usage:       "Auto helmwave.yml.tpl --> helmwave.yml",
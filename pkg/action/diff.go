@@ -1,13 +1,26 @@
 package action
 
 import (
+	"errors"
+
+	"github.com/helmwave/helmwave/pkg/plan"
+	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
+// DiffExitCodeChanges is an exit code for diff with changes when detailed exit code is enabled.
+const DiffExitCodeChanges = 2
+
+// ErrDiffHasChanges is returned with DiffExitCodeChanges exit code when diff has changes.
+var ErrDiffHasChanges = errors.New("🆚 plan has changes")
+
 // Diff is struct for running 'diff' commands.
 type Diff struct {
-	ShowSecret bool
-	Wide       int
+	Output           string
+	ShowSecret       bool
+	ShowDecrypted    bool
+	DetailedExitCode bool
+	Wide             int
 }
 
 // Cmd returns 'diff' *cli.Command.
@@ -19,7 +32,7 @@ func (d *Diff) Cmd() *cli.Command {
 		Name:    "diff",
 		Usage:   "🆚 Show Differences",
 		Aliases: []string{"vs"},
-		Flags:   d.flags(),
+		Flags:   append(d.flags(), flagDiffDetailedExitCode(&d.DetailedExitCode)),
 		Subcommands: []*cli.Command{
 			plan.Cmd(),
 			live.Cmd(),
@@ -32,5 +45,28 @@ func (d *Diff) flags() []cli.Flag {
 	return []cli.Flag{
 		flagDiffWide(&d.Wide),
 		flagDiffShowSecret(&d.ShowSecret),
+		flagDiffShowDecrypted(&d.ShowDecrypted),
+		flagDiffOutput(&d.Output),
+	}
+}
+
+// render writes diff report in output format.
+func (d *Diff) render(report *plan.DiffReport) error {
+	return report.Render(outputWriter(d.Output), d.Output)
+}
+
+// renderSummary writes summary table of report to the logger output. It is skipped for structured formats.
+func (d *Diff) renderSummary(report *plan.DiffReport) {
+	if plan.IsTextOutput(d.Output) {
+		report.Summary().Render(log.StandardLogger().Out)
+	}
+}
+
+// exitCode returns error with DiffExitCodeChanges exit code if detailed exit code is enabled and report has changes.
+func (d *Diff) exitCode(report *plan.DiffReport) error {
+	if !d.DetailedExitCode || !report.HasChanges() {
+		return nil
 	}
+
+	return cli.Exit(ErrDiffHasChanges, DiffExitCodeChanges)
 }
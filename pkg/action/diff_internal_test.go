@@ -0,0 +1,50 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/stretchr/testify/suite"
+	"github.com/urfave/cli/v2"
+)
+
+type DiffTestSuite struct {
+	suite.Suite
+}
+
+func (ts *DiffTestSuite) TestExitCodeDisabled() {
+	d := &Diff{}
+	report := &plan.DiffReport{
+		Releases: []*plan.ReleaseDiff{{Change: plan.ChangeAdded}},
+	}
+
+	ts.Require().NoError(d.exitCode(report))
+}
+
+func (ts *DiffTestSuite) TestExitCodeNoChanges() {
+	d := &Diff{DetailedExitCode: true}
+	report := &plan.DiffReport{
+		Releases: []*plan.ReleaseDiff{{Change: plan.ChangeUnchanged}},
+	}
+
+	ts.Require().NoError(d.exitCode(report))
+}
+
+func (ts *DiffTestSuite) TestExitCodeChanges() {
+	d := &Diff{DetailedExitCode: true}
+	report := &plan.DiffReport{
+		Releases: []*plan.ReleaseDiff{{Change: plan.ChangeModified}},
+	}
+
+	err := d.exitCode(report)
+	ts.Require().Error(err)
+
+	var exitErr cli.ExitCoder
+	ts.Require().ErrorAs(err, &exitErr)
+	ts.Require().Equal(DiffExitCodeChanges, exitErr.ExitCode())
+}
+
+func TestDiffTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DiffTestSuite))
+}
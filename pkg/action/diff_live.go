@@ -9,8 +9,9 @@ import (
 
 // DiffLive is struct for running 'diff live' command.
 type DiffLive struct {
-	diff    *Diff
-	plandir string
+	diff     *Diff
+	plandir  string
+	revision int
 }
 
 // Run is main function for 'diff live' command.
@@ -24,9 +25,16 @@ func (d *DiffLive) Run() error {
 		return os.ErrNotExist
 	}
 
-	p.DiffLive(d.diff.ShowSecret, d.diff.Wide)
+	report, err := p.DiffLive(d.diff.ShowSecret, d.diff.ShowDecrypted, d.diff.Wide, d.revision)
+	if err != nil {
+		return err
+	}
+
+	if err := d.diff.render(report); err != nil {
+		return err
+	}
 
-	return nil
+	return d.diff.exitCode(report)
 }
 
 // Cmd returns 'diff live' *cli.Command.
@@ -43,5 +51,12 @@ func (d *DiffLive) Cmd() *cli.Command {
 func (d *DiffLive) flags() []cli.Flag {
 	return []cli.Flag{
 		flagPlandir(&d.plandir),
+		&cli.IntFlag{
+			Name:        "revision",
+			Value:       0,
+			Usage:       "Live revision to diff with: N, -1 for the previous one or 0 for the last deployed",
+			EnvVars:     []string{"HELMWAVE_DIFF_REVISION"},
+			Destination: &d.revision,
+		},
 	}
 }
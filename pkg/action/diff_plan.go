@@ -39,9 +39,13 @@ func (d *DiffLocalPlan) Run() error {
 		return os.ErrNotExist
 	}
 
-	plan1.DiffPlan(plan2, d.diff.ShowSecret, d.diff.Wide)
+	report := plan1.DiffPlan(plan2, d.diff.ShowSecret, d.diff.Wide)
+	if err := d.diff.render(report); err != nil {
+		return err
+	}
+	d.diff.renderSummary(report)
 
-	return nil
+	return d.diff.exitCode(report)
 }
 
 // Cmd returns 'diff plan' *cli.Command.
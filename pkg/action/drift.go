@@ -0,0 +1,64 @@
+package action
+
+import (
+	"os"
+
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/urfave/cli/v2"
+)
+
+// Drift is struct for running 'drift' command.
+type Drift struct {
+	diff    *Diff
+	plandir string
+}
+
+// Run is main function for 'drift' command.
+func (d *Drift) Run() error {
+	p, err := plan.NewAndImport(d.plandir)
+	if err != nil {
+		return err
+	}
+
+	if ok := p.IsManifestExist(); !ok {
+		return os.ErrNotExist
+	}
+
+	report, err := p.Drift(helper.NewDynamic, d.diff.ShowSecret, d.diff.Wide)
+	if err != nil {
+		return err
+	}
+
+	if err := d.diff.render(report); err != nil {
+		return err
+	}
+
+	if report.HasChanges() {
+		return cli.Exit(plan.ErrDriftDetected, DiffExitCodeChanges)
+	}
+
+	return nil
+}
+
+// Cmd returns 'drift' *cli.Command.
+func (d *Drift) Cmd() *cli.Command {
+	return &cli.Command{
+		Name:   "drift",
+		Usage:  "🆚 Show drift of live objects from plan",
+		Flags:  d.flags(),
+		Action: toCtx(d.Run),
+	}
+}
+
+// flags return flag set of CLI urfave.
+func (d *Drift) flags() []cli.Flag {
+	// Init sub-structures
+	d.diff = &Diff{}
+
+	self := []cli.Flag{
+		flagPlandir(&d.plandir),
+	}
+
+	return append(self, d.diff.flags()...)
+}
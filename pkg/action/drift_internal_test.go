@@ -0,0 +1,27 @@
+package action
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DriftTestSuite struct {
+	suite.Suite
+}
+
+func (ts *DriftTestSuite) TestImplementsAction() {
+	ts.Require().Implements((*Action)(nil), &Drift{})
+}
+
+func (ts *DriftTestSuite) TestNoPlan() {
+	d := &Drift{diff: &Diff{}, plandir: ts.T().TempDir()}
+
+	ts.Require().ErrorIs(d.Run(), os.ErrNotExist)
+}
+
+func TestDriftTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DriftTestSuite))
+}
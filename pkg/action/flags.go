@@ -1,7 +1,11 @@
 package action
 
 import (
+	"io"
+	"os"
+
 	"github.com/helmwave/helmwave/pkg/plan"
+	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
@@ -89,12 +93,60 @@ func flagDiffShowSecret(v *bool) *cli.BoolFlag {
 	return &cli.BoolFlag{
 		Name:        "show-secret",
 		Value:       true,
-		Usage:       "Show secret in diff",
+		Usage:       "Show secret in diff. Otherwise secrets and secret-like values (password, token, etc.) are masked",
 		EnvVars:     []string{"HELMWAVE_DIFF_SHOW_SECRET"},
 		Destination: v,
 	}
 }
 
+// flagDiffShowDecrypted pass val to urfave flag.
+func flagDiffShowDecrypted(v *bool) *cli.BoolFlag {
+	return &cli.BoolFlag{
+		Name:        "show-decrypted",
+		Value:       false,
+		Usage:       "Show values decrypted with sops in values diff. Otherwise they are masked",
+		EnvVars:     []string{"HELMWAVE_DIFF_SHOW_DECRYPTED"},
+		Destination: v,
+	}
+}
+
+// flagDiffOutput pass val to urfave flag.
+func flagDiffOutput(v *string) *cli.StringFlag {
+	return flagOutput("diff-output", "HELMWAVE_DIFF_OUTPUT", v)
+}
+
+// flagOutput pass val to urfave flag of report output format.
+func flagOutput(name, env string, v *string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name:        name,
+		Value:       plan.OutputText,
+		Usage:       "You can set: [ text | json | yaml ]",
+		EnvVars:     []string{env},
+		Destination: v,
+	}
+}
+
+// outputWriter returns writer for report output format.
+// Text goes to the logger output, structured formats go to stdout, so they can be piped.
+func outputWriter(format string) io.Writer {
+	if plan.IsTextOutput(format) {
+		return log.StandardLogger().Out
+	}
+
+	return os.Stdout
+}
+
+// flagDiffDetailedExitCode pass val to urfave flag.
+func flagDiffDetailedExitCode(v *bool) *cli.BoolFlag {
+	return &cli.BoolFlag{
+		Name:        "detailed-exitcode",
+		Value:       false,
+		Usage:       "Return exit code 2 if diff has changes, 1 on errors and 0 otherwise",
+		EnvVars:     []string{"HELMWAVE_DIFF_DETAILED_EXITCODE"},
+		Destination: v,
+	}
+}
+
 // flagTemplateEngine pass val to urfave flag.
 func flagTemplateEngine(v *string) *cli.StringFlag {
 	return &cli.StringFlag{
@@ -116,3 +168,14 @@ func flagAutoBuild(v *bool) *cli.BoolFlag {
 		Destination: v,
 	}
 }
+
+// flagRevision pass val to urfave flag.
+func flagRevision(v *int) *cli.IntFlag {
+	return &cli.IntFlag{
+		Name:        "revision",
+		Value:       0,
+		Usage:       "Revision of release, negative is relative to the latest one. Last deployed by default",
+		EnvVars:     []string{"HELMWAVE_REVISION"},
+		Destination: v,
+	}
+}
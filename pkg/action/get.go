@@ -0,0 +1,24 @@
+package action
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Get is struct for running 'get' commands.
+type Get struct{}
+
+// Cmd returns 'get' *cli.Command.
+func (g *Get) Cmd() *cli.Command {
+	values := GetValues{}
+	subcommands := []*cli.Command{values.Cmd()}
+
+	for _, r := range getReleaseCommands() {
+		subcommands = append(subcommands, r.Cmd())
+	}
+
+	return &cli.Command{
+		Name:        "get",
+		Usage:       "📥 Get details of deployed releases",
+		Subcommands: subcommands,
+	}
+}
@@ -0,0 +1,36 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GetTestSuite struct {
+	suite.Suite
+}
+
+func (ts *GetTestSuite) TestImplementsAction() {
+	ts.Require().Implements((*Action)(nil), &GetValues{})
+	ts.Require().Implements((*Action)(nil), &GetRelease{})
+}
+
+func (ts *GetTestSuite) TestReleaseCommands() {
+	names := make([]string, 0)
+	for _, r := range getReleaseCommands() {
+		names = append(names, r.Cmd().Name)
+	}
+
+	ts.Require().Equal([]string{"manifest", "notes", "hooks"}, names)
+}
+
+func (ts *GetTestSuite) TestNoPlan() {
+	s := &GetValues{plandir: ts.T().TempDir()}
+
+	ts.Require().Error(s.Run())
+}
+
+func TestGetTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetTestSuite))
+}
@@ -0,0 +1,62 @@
+package action
+
+import (
+	"os"
+
+	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/urfave/cli/v2"
+	live "helm.sh/helm/v3/pkg/release"
+)
+
+// GetRelease is struct for running 'get' commands that show a part of deployed releases.
+type GetRelease struct {
+	plandir  string
+	revision int
+	names    []string
+
+	name    string
+	usage   string
+	extract func(*live.Release) string
+}
+
+// getReleaseCommands returns 'get manifest', 'get notes' and 'get hooks' commands.
+func getReleaseCommands() []*GetRelease {
+	return []*GetRelease{
+		{name: "manifest", usage: "Show manifest of deployed releases", extract: plan.ReleaseManifest},
+		{name: "notes", usage: "Show notes of deployed releases", extract: plan.ReleaseNotes},
+		{name: "hooks", usage: "Show hooks of deployed releases", extract: plan.ReleaseHooks},
+	}
+}
+
+// Run is main function for 'get' command.
+func (g *GetRelease) Run() error {
+	p, err := plan.NewAndImport(g.plandir)
+	if err != nil {
+		return err
+	}
+
+	return p.GetReleases(os.Stdout, g.revision, g.extract, g.names...)
+}
+
+// Cmd returns 'get' subcommand *cli.Command.
+func (g *GetRelease) Cmd() *cli.Command {
+	return &cli.Command{
+		Name:      g.name,
+		Usage:     g.usage,
+		ArgsUsage: "[uniqname...]",
+		Flags:     g.flags(),
+		Action: func(c *cli.Context) error {
+			g.names = c.Args().Slice()
+
+			return g.Run()
+		},
+	}
+}
+
+// flags return flag set of CLI urfave.
+func (g *GetRelease) flags() []cli.Flag {
+	return []cli.Flag{
+		flagPlandir(&g.plandir),
+		flagRevision(&g.revision),
+	}
+}
@@ -0,0 +1,56 @@
+package action
+
+import (
+	"os"
+
+	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/urfave/cli/v2"
+)
+
+// GetValues is struct for running 'get values' command.
+type GetValues struct {
+	plandir  string
+	revision int
+	all      bool
+	names    []string
+}
+
+// Run is main function for 'get values' command.
+func (g *GetValues) Run() error {
+	p, err := plan.NewAndImport(g.plandir)
+	if err != nil {
+		return err
+	}
+
+	return p.GetValues(os.Stdout, g.revision, g.all, g.names...)
+}
+
+// Cmd returns 'get values' *cli.Command.
+func (g *GetValues) Cmd() *cli.Command {
+	return &cli.Command{
+		Name:      "values",
+		Usage:     "Show values of deployed releases",
+		ArgsUsage: "[uniqname...]",
+		Flags:     g.flags(),
+		Action: func(c *cli.Context) error {
+			g.names = c.Args().Slice()
+
+			return g.Run()
+		},
+	}
+}
+
+// flags return flag set of CLI urfave.
+func (g *GetValues) flags() []cli.Flag {
+	return []cli.Flag{
+		flagPlandir(&g.plandir),
+		flagRevision(&g.revision),
+		&cli.BoolFlag{
+			Name:        "all",
+			Value:       false,
+			Usage:       "Show all computed values instead of user supplied ones",
+			EnvVars:     []string{"HELMWAVE_GET_VALUES_ALL"},
+			Destination: &g.all,
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package action
+
+import (
+	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/urfave/cli/v2"
+)
+
+// History is struct for running 'history' command.
+type History struct {
+	plandir  string
+	output   string
+	tags     cli.StringSlice
+	matchAll bool
+	max      int
+}
+
+// Run is main function for 'history' command.
+func (h *History) Run() error {
+	p, err := plan.NewAndImport(h.plandir)
+	if err != nil {
+		return err
+	}
+
+	report := p.History(h.max, normalizeTagList(h.tags.Value()), h.matchAll)
+
+	return report.Render(outputWriter(h.output), h.output)
+}
+
+// Cmd returns 'history' *cli.Command.
+func (h *History) Cmd() *cli.Command {
+	return &cli.Command{
+		Name:   "history",
+		Usage:  "📜 Revision history of releases",
+		Flags:  h.flags(),
+		Action: toCtx(h.Run),
+	}
+}
+
+// flags return flag set of CLI urfave.
+func (h *History) flags() []cli.Flag {
+	return []cli.Flag{
+		flagPlandir(&h.plandir),
+		flagTags(&h.tags),
+		flagMatchAllTags(&h.matchAll),
+		&cli.IntFlag{
+			Name:        "max",
+			Usage:       "Maximum number of revisions to show for each release",
+			Value:       10,
+			EnvVars:     []string{"HELMWAVE_HISTORY_MAX"},
+			Destination: &h.max,
+		},
+		flagOutput("output", "HELMWAVE_HISTORY_OUTPUT", &h.output),
+	}
+}
@@ -0,0 +1,50 @@
+package action
+
+import (
+	"github.com/helmwave/helmwave/pkg/plan"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Lock is struct for running 'lock' command.
+type Lock struct {
+	file      string
+	templater string
+	update    bool
+}
+
+// Run is main function for 'lock' command.
+func (l *Lock) Run() error {
+	if err := plan.New(plan.Dir).Lock(l.file, l.templater, l.update); err != nil {
+		return err
+	}
+
+	log.WithField("file", plan.LockPath(l.file)).Info("🔒 Lock file is ready!")
+
+	return nil
+}
+
+// Cmd returns 'lock' *cli.Command.
+func (l *Lock) Cmd() *cli.Command {
+	return &cli.Command{
+		Name:   "lock",
+		Usage:  "🔒 Resolve chart versions into lock file",
+		Flags:  l.flags(),
+		Action: toCtx(l.Run),
+	}
+}
+
+// flags return flag set of CLI urfave.
+func (l *Lock) flags() []cli.Flag {
+	return []cli.Flag{
+		flagYmlFile(&l.file),
+		flagTemplateEngine(&l.templater),
+		&cli.BoolFlag{
+			Name:        "update",
+			Usage:       "Resolve all chart versions again instead of using locked ones",
+			Value:       false,
+			EnvVars:     []string{"HELMWAVE_LOCK_UPDATE"},
+			Destination: &l.update,
+		},
+	}
+}
@@ -0,0 +1,23 @@
+package action
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Show is struct for running 'show' commands.
+type Show struct{}
+
+// Cmd returns 'show' *cli.Command.
+func (s *Show) Cmd() *cli.Command {
+	values := ShowValues{}
+	store := ShowStore{}
+
+	return &cli.Command{
+		Name:  "show",
+		Usage: "🔎 Show details of plan",
+		Subcommands: []*cli.Command{
+			values.Cmd(),
+			store.Cmd(),
+		},
+	}
+}
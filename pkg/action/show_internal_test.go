@@ -0,0 +1,27 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ShowTestSuite struct {
+	suite.Suite
+}
+
+func (ts *ShowTestSuite) TestImplementsAction() {
+	ts.Require().Implements((*Action)(nil), &ShowValues{})
+	ts.Require().Implements((*Action)(nil), &ShowStore{})
+}
+
+func (ts *ShowTestSuite) TestNoPlan() {
+	s := &ShowValues{plandir: ts.T().TempDir()}
+
+	ts.Require().Error(s.Run())
+}
+
+func TestShowTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ShowTestSuite))
+}
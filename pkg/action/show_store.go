@@ -0,0 +1,46 @@
+package action
+
+import (
+	"os"
+
+	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/urfave/cli/v2"
+)
+
+// ShowStore is struct for running 'show store' command.
+type ShowStore struct {
+	plandir string
+	names   []string
+}
+
+// Run is main function for 'show store' command.
+func (s *ShowStore) Run() error {
+	p, err := plan.NewAndImport(s.plandir)
+	if err != nil {
+		return err
+	}
+
+	return p.ShowStore(os.Stdout, s.names...)
+}
+
+// Cmd returns 'show store' *cli.Command.
+func (s *ShowStore) Cmd() *cli.Command {
+	return &cli.Command{
+		Name:      "store",
+		Usage:     "Show store of releases merged with project store",
+		ArgsUsage: "[uniqname...]",
+		Flags:     s.flags(),
+		Action: func(c *cli.Context) error {
+			s.names = c.Args().Slice()
+
+			return s.Run()
+		},
+	}
+}
+
+// flags return flag set of CLI urfave.
+func (s *ShowStore) flags() []cli.Flag {
+	return []cli.Flag{
+		flagPlandir(&s.plandir),
+	}
+}
@@ -0,0 +1,46 @@
+package action
+
+import (
+	"os"
+
+	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/urfave/cli/v2"
+)
+
+// ShowValues is struct for running 'show values' command.
+type ShowValues struct {
+	plandir string
+	names   []string
+}
+
+// Run is main function for 'show values' command.
+func (s *ShowValues) Run() error {
+	p, err := plan.NewAndImport(s.plandir)
+	if err != nil {
+		return err
+	}
+
+	return p.ShowValues(os.Stdout, s.names...)
+}
+
+// Cmd returns 'show values' *cli.Command.
+func (s *ShowValues) Cmd() *cli.Command {
+	return &cli.Command{
+		Name:      "values",
+		Usage:     "Show merged values of releases",
+		ArgsUsage: "[uniqname...]",
+		Flags:     s.flags(),
+		Action: func(c *cli.Context) error {
+			s.names = c.Args().Slice()
+
+			return s.Run()
+		},
+	}
+}
+
+// flags return flag set of CLI urfave.
+func (s *ShowValues) flags() []cli.Flag {
+	return []cli.Flag{
+		flagPlandir(&s.plandir),
+	}
+}
@@ -0,0 +1,77 @@
+package action
+
+import (
+	"time"
+
+	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/urfave/cli/v2"
+)
+
+// Test is struct for running 'test' command.
+type Test struct {
+	plandir string
+	output  string
+	timeout time.Duration
+	logs    bool
+	names   []string
+}
+
+// Run is main function for 'test' command.
+func (t *Test) Run() error {
+	p, err := plan.NewAndImport(t.plandir)
+	if err != nil {
+		return err
+	}
+
+	report, err := p.Test(t.timeout, t.logs, t.names...)
+	if err != nil {
+		return err
+	}
+
+	if err := report.Render(outputWriter(t.output), t.output); err != nil {
+		return err
+	}
+
+	if report.HasFailures() {
+		return plan.ErrTestFailed
+	}
+
+	return nil
+}
+
+// Cmd returns 'test' *cli.Command.
+func (t *Test) Cmd() *cli.Command {
+	return &cli.Command{
+		Name:      "test",
+		Usage:     "🧪 Run helm tests of releases",
+		ArgsUsage: "[uniqname...]",
+		Flags:     t.flags(),
+		Action: func(c *cli.Context) error {
+			t.names = c.Args().Slice()
+
+			return t.Run()
+		},
+	}
+}
+
+// flags return flag set of CLI urfave.
+func (t *Test) flags() []cli.Flag {
+	return []cli.Flag{
+		flagPlandir(&t.plandir),
+		&cli.DurationFlag{
+			Name:        "timeout",
+			Usage:       "Time to wait for each test pod",
+			Value:       5 * time.Minute,
+			EnvVars:     []string{"HELMWAVE_TEST_TIMEOUT"},
+			Destination: &t.timeout,
+		},
+		&cli.BoolFlag{
+			Name:        "logs",
+			Usage:       "Collect logs of test pods",
+			Value:       false,
+			EnvVars:     []string{"HELMWAVE_TEST_LOGS"},
+			Destination: &t.logs,
+		},
+		flagOutput("output", "HELMWAVE_TEST_OUTPUT", &t.output),
+	}
+}
@@ -0,0 +1,31 @@
+package helper
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/dynamic"
+)
+
+// NewDynamic creates kubernetes dynamic client and REST mapper for provided kube context and kubeconfig.
+// Empty kube context and kubeconfig fall back to global ones.
+func NewDynamic(kubeContext, kubeconfig string) (dynamic.Interface, meta.RESTMapper, error) {
+	config := NewConfigFlags(kubeContext, kubeconfig)
+
+	restConfig, err := config.ToRESTConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get kubernetes config: %w", err)
+	}
+
+	client, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create kubernetes dynamic client: %w", err)
+	}
+
+	mapper, err := config.ToRESTMapper()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create kubernetes REST mapper: %w", err)
+	}
+
+	return client, mapper, nil
+}
@@ -2,7 +2,6 @@ package helper
 
 import (
 	"fmt"
-	"os"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
@@ -33,20 +32,19 @@ func init() { //nolint:gochecknoinits
 	}
 }
 
-// NewCfg creates helm internal configuration for provided namespace.
-func NewCfg(ns string) (*action.Configuration, error) {
+// NewCfg creates helm internal configuration for provided namespace, kube context, kubeconfig and helm driver.
+// Empty kube context and kubeconfig fall back to global ones, empty helm driver means helm default (secret).
+// HELM_DRIVER environment variable is ignored.
+func NewCfg(ns, kubeContext, kubeconfig string, helmDriver HelmDriver) (*action.Configuration, error) {
 	cfg := new(action.Configuration)
-	helmDriver := os.Getenv("HELM_DRIVER") // TODO: get rid of getenv in runtime
-	config := genericclioptions.NewConfigFlags(false)
+	config := NewConfigFlags(kubeContext, kubeconfig)
 	config.Namespace = &ns
-	config.Context = &Helm.KubeContext
 
 	if Helm.Debug {
 		helmLogLevel = log.Infof
 	}
-	err := cfg.Init(config, ns, helmDriver, helmLogLevel)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create helm configuration for %s namespace: %w", ns, err)
+	if err := helmDriver.init(cfg, config, ns); err != nil {
+		return nil, err
 	}
 
 	cfg.RegistryClient = HelmRegistryClient
@@ -55,7 +53,8 @@ func NewCfg(ns string) (*action.Configuration, error) {
 }
 
 // NewHelm is a hack to create an instance of helm CLI and specifying namespace without environment variables.
-func NewHelm(ns string) (*helm.EnvSettings, error) {
+// Empty kube context and kubeconfig fall back to global ones.
+func NewHelm(ns, kubeContext, kubeconfig string) (*helm.EnvSettings, error) {
 	env := helm.New()
 	fs := &pflag.FlagSet{}
 	env.AddFlags(fs)
@@ -65,5 +64,33 @@ func NewHelm(ns string) (*helm.EnvSettings, error) {
 		return nil, fmt.Errorf("failed to set namespace %s for helm: %w", ns, err)
 	}
 
+	env.KubeContext, env.KubeConfig = KubeTarget(kubeContext, kubeconfig)
+
 	return env, nil
 }
+
+// KubeTarget returns kube context and kubeconfig to use, falling back to global ones if they are empty.
+func KubeTarget(kubeContext, kubeconfig string) (string, string) {
+	if kubeContext == "" {
+		kubeContext = Helm.KubeContext
+	}
+
+	if kubeconfig == "" {
+		kubeconfig = Helm.KubeConfig
+	}
+
+	return kubeContext, kubeconfig
+}
+
+// NewConfigFlags creates kubernetes client config flags for provided kube context and kubeconfig.
+func NewConfigFlags(kubeContext, kubeconfig string) *genericclioptions.ConfigFlags {
+	kubeContext, kubeconfig = KubeTarget(kubeContext, kubeconfig)
+
+	config := genericclioptions.NewConfigFlags(false)
+	config.Context = &kubeContext
+	if kubeconfig != "" {
+		config.KubeConfig = &kubeconfig
+	}
+
+	return config
+}
@@ -0,0 +1,168 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	// HelmDriverSecret stores releases in kubernetes secrets. It is helm default.
+	HelmDriverSecret = "secret"
+
+	// HelmDriverConfigMap stores releases in kubernetes configmaps.
+	HelmDriverConfigMap = "configmap"
+
+	// HelmDriverMemory stores releases in memory. Releases are lost when helmwave exits.
+	HelmDriverMemory = "memory"
+
+	// HelmDriverSQL stores releases in SQL database.
+	HelmDriverSQL = "sql"
+)
+
+var (
+	// ErrUnknownHelmDriver is returned when unsupported helm driver is configured.
+	ErrUnknownHelmDriver = errors.New("unknown helm driver")
+
+	// ErrHelmDriverSQLConnection is returned when connection string of sql helm driver is not set in environment.
+	ErrHelmDriverSQLConnection = errors.New("sql helm driver requires connection string")
+)
+
+// DefaultHelmDriverSQLConnectionEnv is environment variable helm reads sql connection string from.
+const DefaultHelmDriverSQLConnectionEnv = "HELM_DRIVER_SQL_CONNECTION_STRING"
+
+// memoryDrivers keeps memory storages alive between helm configurations of the same namespace and cluster.
+var memoryDrivers = struct {
+	sync.Mutex
+	drivers map[string]*driver.Memory
+}{drivers: make(map[string]*driver.Memory)}
+
+// HelmDriver is a helm storage driver configuration.
+// It can be set either as a string with driver name or as a map.
+// Connection string of sql driver contains credentials, so only the name of environment variable is stored.
+type HelmDriver struct {
+	Name                string `yaml:"name,omitempty"`
+	ConnectionStringEnv string `yaml:"connection_string_env,omitempty"`
+}
+
+// UnmarshalYAML parses helm driver either from string or from map and validates it.
+func (d *HelmDriver) UnmarshalYAML(node *yaml.Node) error {
+	type raw HelmDriver
+
+	switch node.Kind {
+	case yaml.ScalarNode, yaml.AliasNode:
+		if err := node.Decode(&d.Name); err != nil {
+			return fmt.Errorf("failed to decode helm driver %q from YAML: %w", node.Value, err)
+		}
+	case yaml.MappingNode:
+		if err := node.Decode((*raw)(d)); err != nil {
+			return fmt.Errorf("failed to decode helm driver from YAML: %w", err)
+		}
+	default:
+		return fmt.Errorf("failed to decode helm driver %q from YAML: unknown format", node.Value)
+	}
+
+	return d.Validate()
+}
+
+// MarshalYAML writes helm driver as a string if connection string environment variable is not set.
+func (d HelmDriver) MarshalYAML() (interface{}, error) {
+	if d.ConnectionStringEnv == "" {
+		return d.Name, nil
+	}
+
+	type raw HelmDriver
+
+	return raw(d), nil
+}
+
+// IsZero is used by yaml encoder to omit empty helm driver.
+func (d HelmDriver) IsZero() bool {
+	return d.Name == "" && d.ConnectionStringEnv == ""
+}
+
+// ConnectionString reads sql connection string from environment.
+// HELM_DRIVER_SQL_CONNECTION_STRING is used if connection_string_env is not set.
+func (d HelmDriver) ConnectionString() string {
+	env := d.ConnectionStringEnv
+	if env == "" {
+		env = DefaultHelmDriverSQLConnectionEnv
+	}
+
+	return os.Getenv(env)
+}
+
+// Validate checks that helm driver is supported.
+func (d HelmDriver) Validate() error {
+	switch d.Name {
+	case "", HelmDriverSecret, "secrets", HelmDriverConfigMap, "configmaps", HelmDriverMemory, HelmDriverSQL:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownHelmDriver, d.Name)
+	}
+}
+
+// init sets up releases storage of helm configuration.
+// SQL driver is created here because helm reads its connection string from fixed environment variable only.
+func (d HelmDriver) init(cfg *action.Configuration, getter *genericclioptions.ConfigFlags, ns string) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+
+	switch d.Name {
+	case HelmDriverSQL:
+		if err := cfg.Init(getter, ns, HelmDriverSecret, helmLogLevel); err != nil {
+			return fmt.Errorf("failed to create helm configuration for %s namespace: %w", ns, err)
+		}
+
+		conn := d.ConnectionString()
+		if conn == "" {
+			return ErrHelmDriverSQLConnection
+		}
+
+		sql, err := driver.NewSQL(conn, helmLogLevel, ns)
+		if err != nil {
+			return fmt.Errorf("failed to create sql helm driver: %w", err)
+		}
+
+		cfg.Releases = storage.Init(sql)
+	case HelmDriverMemory:
+		if err := cfg.Init(getter, ns, HelmDriverSecret, helmLogLevel); err != nil {
+			return fmt.Errorf("failed to create helm configuration for %s namespace: %w", ns, err)
+		}
+
+		cfg.Releases = storage.Init(memoryDriver(ns, getter))
+	default:
+		if err := cfg.Init(getter, ns, d.Name, helmLogLevel); err != nil {
+			return fmt.Errorf("failed to create helm configuration for %s namespace: %w", ns, err)
+		}
+	}
+
+	return nil
+}
+
+// memoryDriver returns memory storage shared by all helm configurations of the namespace in the cluster.
+// Helm creates a new empty one each time, so releases would be lost between helmwave steps.
+func memoryDriver(ns string, getter *genericclioptions.ConfigFlags) *driver.Memory {
+	key := fmt.Sprintf("%s@%s@%s", ns, *getter.Context, *getter.KubeConfig)
+
+	memoryDrivers.Lock()
+	defer memoryDrivers.Unlock()
+
+	if m, found := memoryDrivers.drivers[key]; found {
+		return m
+	}
+
+	m := driver.NewMemory()
+	m.SetNamespace(ns)
+	memoryDrivers.drivers[key] = m
+
+	return m
+}
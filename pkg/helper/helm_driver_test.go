@@ -0,0 +1,101 @@
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+type HelmDriverTestSuite struct {
+	suite.Suite
+}
+
+func (s *HelmDriverTestSuite) TestUnmarshalString() {
+	d := helper.HelmDriver{}
+
+	s.Require().NoError(yaml.Unmarshal([]byte("configmap"), &d))
+	s.Require().Equal(helper.HelmDriver{Name: helper.HelmDriverConfigMap}, d)
+}
+
+func (s *HelmDriverTestSuite) TestUnmarshalMap() {
+	d := helper.HelmDriver{}
+	src := "name: sql\nconnection_string_env: HELM_DB"
+
+	s.Require().NoError(yaml.Unmarshal([]byte(src), &d))
+	s.Require().Equal(helper.HelmDriver{Name: helper.HelmDriverSQL, ConnectionStringEnv: "HELM_DB"}, d)
+}
+
+func (s *HelmDriverTestSuite) TestUnmarshalUnknown() {
+	d := helper.HelmDriver{}
+
+	s.Require().ErrorIs(yaml.Unmarshal([]byte("blabla"), &d), helper.ErrUnknownHelmDriver)
+}
+
+func (s *HelmDriverTestSuite) TestNewCfgSQLWithoutConnectionString() {
+	d := helper.HelmDriver{Name: helper.HelmDriverSQL, ConnectionStringEnv: "HELMWAVE_TEST_NOT_EXISTING_ENV"}
+
+	_, err := helper.NewCfg(s.T().Name(), "", "", d)
+	s.Require().ErrorIs(err, helper.ErrHelmDriverSQLConnection)
+}
+
+func (s *HelmDriverTestSuite) TestMarshal() {
+	b, err := yaml.Marshal(helper.HelmDriver{Name: helper.HelmDriverMemory})
+
+	s.Require().NoError(err)
+	s.Require().Equal("memory\n", string(b))
+}
+
+func (s *HelmDriverTestSuite) TestMarshalSQL() {
+	b, err := yaml.Marshal(helper.HelmDriver{Name: helper.HelmDriverSQL, ConnectionStringEnv: "HELM_DB"})
+
+	s.Require().NoError(err)
+	s.Require().Equal("name: sql\nconnection_string_env: HELM_DB\n", string(b))
+}
+
+func (s *HelmDriverTestSuite) TestNewCfgMemory() {
+	cfg, err := helper.NewCfg(s.T().Name(), "", "", helper.HelmDriver{Name: helper.HelmDriverMemory})
+
+	s.Require().NoError(err)
+	s.Require().IsType(&driver.Memory{}, cfg.Releases.Driver)
+}
+
+func (s *HelmDriverTestSuite) TestNewCfgMemoryShared() {
+	d := helper.HelmDriver{Name: helper.HelmDriverMemory}
+
+	cfg, err := helper.NewCfg(s.T().Name(), "", "", d)
+	s.Require().NoError(err)
+	s.Require().NoError(cfg.Releases.Create(&release.Release{
+		Name:      "redis",
+		Namespace: s.T().Name(),
+		Version:   1,
+		Info:      &release.Info{Status: release.StatusDeployed},
+	}))
+
+	cfg, err = helper.NewCfg(s.T().Name(), "", "", d)
+	s.Require().NoError(err)
+
+	r, err := cfg.Releases.Last("redis")
+	s.Require().NoError(err)
+	s.Require().Equal(1, r.Version)
+
+	cfg, err = helper.NewCfg(s.T().Name()+"-other", "", "", d)
+	s.Require().NoError(err)
+
+	_, err = cfg.Releases.Last("redis")
+	s.Require().ErrorIs(err, driver.ErrReleaseNotFound)
+}
+
+func (s *HelmDriverTestSuite) TestNewCfgUnknown() {
+	_, err := helper.NewCfg(s.T().Name(), "", "", helper.HelmDriver{Name: "blabla"})
+
+	s.Require().ErrorIs(err, helper.ErrUnknownHelmDriver)
+}
+
+func TestHelmDriverTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(HelmDriverTestSuite))
+}
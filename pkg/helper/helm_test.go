@@ -13,7 +13,7 @@ type HelmTestSuite struct {
 
 func (s *HelmTestSuite) TestNewCfg() {
 	ns := s.T().Name()
-	cfg, err := helper.NewCfg(ns)
+	cfg, err := helper.NewCfg(ns, "", "", helper.HelmDriver{})
 
 	s.Require().NoError(err)
 	s.Require().NotNil(cfg)
@@ -21,13 +21,21 @@ func (s *HelmTestSuite) TestNewCfg() {
 
 func (s *HelmTestSuite) TestNewHelmNS() {
 	ns := s.T().Name()
-	h1, err := helper.NewHelm(ns)
+	h1, err := helper.NewHelm(ns, "", "")
 
 	s.Require().NoError(err)
 	s.Require().NotNil(h1)
 	s.Require().Equal(ns, h1.Namespace())
 }
 
+func (s *HelmTestSuite) TestNewHelmKubeContext() {
+	h, err := helper.NewHelm(s.T().Name(), "blabla", "/tmp/kubeconfig")
+
+	s.Require().NoError(err)
+	s.Require().Equal("blabla", h.KubeContext)
+	s.Require().Equal("/tmp/kubeconfig", h.KubeConfig)
+}
+
 func TestHelmTestSuite(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(HelmTestSuite))
@@ -1,24 +1,35 @@
 package helper
 
 import (
+	"errors"
 	"fmt"
-	"os"
 
 	"github.com/werf/kubedog/pkg/kube"
+	"k8s.io/client-go/kubernetes"
 )
 
-// KubeInit init kubeconfig for kubedog.
-func KubeInit() (err error) {
-	opts := kube.InitOptions{}
-	kubeconfigPath, IsExists := os.LookupEnv("KUBECONFIG")
-	if IsExists {
-		opts.ConfigPath = kubeconfigPath
+// ErrNoKubeConfig is returned when neither kubeconfig nor in-cluster config is found.
+var ErrNoKubeConfig = errors.New("kubernetes config not found")
+
+// NewKubeClient creates kubernetes client for kubedog for provided kube context and kubeconfig.
+// Empty kube context and kubeconfig fall back to global ones.
+func NewKubeClient(kubeContext, kubeconfig string) (kubernetes.Interface, error) {
+	opts := kube.KubeConfigOptions{}
+	opts.Context, opts.ConfigPath = KubeTarget(kubeContext, kubeconfig)
+
+	config, err := kube.GetKubeConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize kubernetes config: %w", err)
+	}
+
+	if config == nil {
+		return nil, ErrNoKubeConfig
 	}
 
-	err = kube.Init(opts)
+	client, err := kubernetes.NewForConfig(config.Config)
 	if err != nil {
-		return fmt.Errorf("failed to initialize kubernetes config: %w", err)
+		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
 	}
 
-	return nil
+	return client, nil
 }
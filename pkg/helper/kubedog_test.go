@@ -7,23 +7,26 @@ import (
 	"github.com/helmwave/helmwave/pkg/helper"
 	"github.com/helmwave/helmwave/tests"
 	"github.com/stretchr/testify/suite"
-	"github.com/werf/kubedog/pkg/kube"
 )
 
 type KubedogTestSuite struct {
 	suite.Suite
 }
 
-func (s *KubedogTestSuite) TestKubeInit() {
-	s.T().Setenv("KUBECONFIG", filepath.Join(tests.Root, "kubeconfig.yaml"))
-	err := helper.KubeInit()
+func (s *KubedogTestSuite) TestNewKubeClient() {
+	client, err := helper.NewKubeClient("testCluster", filepath.Join(tests.Root, "kubeconfig.yaml"))
 
 	s.Require().NoError(err)
-	s.Require().NotNil(kube.Client)
+	s.Require().NotNil(client)
+}
+
+func (s *KubedogTestSuite) TestNewKubeClientUnknownContext() {
+	_, err := helper.NewKubeClient("blabla", filepath.Join(tests.Root, "kubeconfig.yaml"))
+
+	s.Require().Error(err)
 }
 
-//nolint:paralleltest // cannot parallel because of setenv
 func TestKubedogTestSuite(t *testing.T) {
-	// t.Parallel()
+	t.Parallel()
 	suite.Run(t, new(KubedogTestSuite))
 }
@@ -0,0 +1,93 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrHookFailed is returned when hook exits with error and is not allowed to fail.
+var ErrHookFailed = errors.New("hook failed")
+
+// Lifecycle is a set of hooks for plan and release phases.
+type Lifecycle struct {
+	PreBuild  Hooks `yaml:"pre_build,omitempty"`
+	PostBuild Hooks `yaml:"post_build,omitempty"`
+	PreUp     Hooks `yaml:"pre_up,omitempty"`
+	PostUp    Hooks `yaml:"post_up,omitempty"`
+	PreDown   Hooks `yaml:"pre_down,omitempty"`
+	PostDown  Hooks `yaml:"post_down,omitempty"`
+}
+
+// Hooks is a list of hooks that run one by one.
+type Hooks []Hook
+
+// Hook is an external command. Environment of helmwave is passed to command with Env added.
+type Hook struct {
+	Cmd          string            `yaml:"cmd"`
+	Args         []string          `yaml:"args,omitempty"`
+	Env          map[string]string `yaml:"env,omitempty"`
+	Timeout      time.Duration     `yaml:"timeout,omitempty"`
+	AllowFailure bool              `yaml:"allow_failure,omitempty"`
+}
+
+// Run runs hooks in order and stops on the first failed hook that is not allowed to fail.
+func (h Hooks) Run(l *log.Entry, phase string) error {
+	for i := range h {
+		if err := h[i].Run(l.WithField("hook", phase)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Run runs hook and writes its stdout and stderr to logger line by line.
+func (h *Hook) Run(l *log.Entry) error {
+	ctx := context.Background()
+	if h.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.Timeout)
+		defer cancel()
+	}
+
+	l = l.WithField("cmd", h.Cmd)
+	l.WithField("args", h.Args).Info("🪝 running hook...")
+
+	stdout := l.WriterLevel(log.InfoLevel)
+	defer stdout.Close() //nolint:errcheck // closing pipe writer never fails
+	stderr := l.WriterLevel(log.WarnLevel)
+	defer stderr.Close() //nolint:errcheck // closing pipe writer never fails
+
+	cmd := exec.CommandContext(ctx, h.Cmd, h.Args...) //nolint:gosec // running user commands is the point of hooks
+	cmd.Env = os.Environ()
+	for k, v := range h.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err := cmd.Run()
+	if err == nil {
+		l.Info("🪝 hook done")
+
+		return nil
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("timeout %s exceeded: %v", h.Timeout, err) //nolint:errorlint // exit error is not useful for callers
+	}
+
+	if h.AllowFailure {
+		l.WithError(err).Warn("🪝 hook failed but is allowed to fail")
+
+		return nil
+	}
+
+	return fmt.Errorf("%w %s: %v", ErrHookFailed, h.Cmd, err) //nolint:errorlint // only ErrHookFailed is wrapped
+}
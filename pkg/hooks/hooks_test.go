@@ -0,0 +1,72 @@
+package hooks_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/helmwave/helmwave/pkg/hooks"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+)
+
+type HooksTestSuite struct {
+	suite.Suite
+}
+
+func (s *HooksTestSuite) logger() (*log.Entry, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	l := log.New()
+	l.SetOutput(buf)
+
+	return log.NewEntry(l), buf
+}
+
+func (s *HooksTestSuite) TestRun() {
+	l, buf := s.logger()
+
+	h := hooks.Hooks{
+		{
+			Cmd:  "sh",
+			Args: []string{"-c", "echo $HELMWAVE_HOOK_TEST"},
+			Env:  map[string]string{"HELMWAVE_HOOK_TEST": s.T().Name()},
+		},
+	}
+
+	s.Require().NoError(h.Run(l, "pre_up"))
+	s.Require().Eventually(func() bool {
+		return bytes.Contains(buf.Bytes(), []byte(s.T().Name()))
+	}, time.Second, 10*time.Millisecond)
+	s.Require().Contains(buf.String(), "hook=pre_up")
+}
+
+func (s *HooksTestSuite) TestRunFailed() {
+	l, _ := s.logger()
+
+	h := hooks.Hooks{{Cmd: "false"}, {Cmd: "true"}}
+
+	s.Require().ErrorIs(h.Run(l, "post_up"), hooks.ErrHookFailed)
+}
+
+func (s *HooksTestSuite) TestRunAllowFailure() {
+	l, _ := s.logger()
+
+	h := hooks.Hooks{{Cmd: "false", AllowFailure: true}}
+
+	s.Require().NoError(h.Run(l, "pre_down"))
+}
+
+func (s *HooksTestSuite) TestRunTimeout() {
+	l, _ := s.logger()
+
+	h := hooks.Hooks{{Cmd: "sleep", Args: []string{"5"}, Timeout: 50 * time.Millisecond}}
+
+	err := h.Run(l, "pre_build")
+	s.Require().ErrorIs(err, hooks.ErrHookFailed)
+	s.Require().ErrorContains(err, "timeout")
+}
+
+func TestHooksTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(HooksTestSuite))
+}
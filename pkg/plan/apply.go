@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -17,10 +19,10 @@ import (
 	"github.com/helmwave/helmwave/pkg/repo"
 	"github.com/olekukonko/tablewriter"
 	log "github.com/sirupsen/logrus"
-	"github.com/werf/kubedog/pkg/kube"
 	"github.com/werf/kubedog/pkg/tracker"
 	"github.com/werf/kubedog/pkg/trackers/rollout/multitrack"
 	helmRepo "helm.sh/helm/v3/pkg/repo"
+	"k8s.io/client-go/kubernetes"
 )
 
 // ErrDeploy is returned when deploy is failed for whatever reason.
@@ -147,6 +149,10 @@ func SyncRepositories(repositories repo.Configs) error {
 }
 
 func (p *Plan) syncReleases() (err error) {
+	if err := p.body.Lifecycle.PreUp.Run(log.NewEntry(log.StandardLogger()), "pre_up"); err != nil {
+		return err
+	}
+
 	wg := parallel.NewWaitGroup()
 	wg.Add(len(p.body.Releases))
 
@@ -179,59 +185,72 @@ func (p *Plan) syncReleases() (err error) {
 	}
 
 	if err := wg.Wait(); err != nil {
+		p.ApplyReport(fails) //nolint:errcheck // deploy error is more specific
+
+		return err
+	}
+
+	if err := p.body.Lifecycle.PostUp.Run(log.NewEntry(log.StandardLogger()), "post_up"); err != nil {
 		return err
 	}
 
 	return p.ApplyReport(fails)
 }
 
-// ApplyReport renders table report for failed releases.
+// ApplyReport renders table report for failed releases and releases that succeeded after retries.
 func (p *Plan) ApplyReport(fails map[release.Config]error) error {
 	n := len(p.body.Releases)
 	k := len(fails)
 
 	log.Infof("Success %d / %d", n-k, n)
 
+	p.renderApplyReport(os.Stdout, fails)
+
 	if len(fails) > 0 {
-		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"name", "namespace", "chart", "version", "err"})
-		table.SetAutoFormatHeaders(true)
-		table.SetBorder(false)
-
-		for r, err := range fails {
-			row := []string{
-				r.Name(),
-				r.Namespace(),
-				r.Chart().Name,
-				r.Chart().Version,
-				err.Error(),
-			}
+		return ErrDeploy
+	}
+
+	return nil
+}
 
-			table.Rich(row, []tablewriter.Colors{
-				{},
-				{},
-				{},
-				{},
-				FailStatusColor,
-			})
+func (p *Plan) renderApplyReport(w io.Writer, fails map[release.Config]error) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"name", "namespace", "chart", "version", "attempts", "err"})
+	table.SetAutoFormatHeaders(true)
+	table.SetBorder(false)
+
+	rows := 0
+	for _, r := range p.body.Releases {
+		err, failed := fails[r]
+		if !failed && r.Attempts() <= 1 {
+			continue
 		}
 
-		table.Render()
+		row := []string{
+			r.Name(),
+			r.Namespace(),
+			r.Chart().Name,
+			r.Chart().Version,
+			strconv.Itoa(r.Attempts()),
+			"",
+		}
+		colors := []tablewriter.Colors{{}, {}, {}, {}, {}, {}}
 
-		return ErrDeploy
+		if failed {
+			row[5] = err.Error()
+			colors[5] = FailStatusColor
+		}
+
+		table.Rich(row, colors)
+		rows++
 	}
 
-	return nil
+	if rows > 0 {
+		table.Render()
+	}
 }
 
 func (p *Plan) syncReleasesKubedog(kubedogConfig *kubedog.Config) (err error) {
-	err = helper.KubeInit()
-	if err != nil {
-		return err
-	}
-	// kube.Context = helper.Helm.KubeContext
-	// kube.DefaultNamespace = helper.Helm.Namespace()
-
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel() // Dont forget!
 
@@ -244,15 +263,26 @@ func (p *Plan) syncReleasesKubedog(kubedogConfig *kubedog.Config) (err error) {
 		},
 	}
 
+	// Every cluster is tracked by its own multitrack
 	specs := p.kubedogSpecs()
+	clients := make(map[kubeTarget]kubernetes.Interface, len(specs))
+	for t := range specs {
+		clients[t], err = helper.NewKubeClient(t.context, t.kubeconfig)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Run kubedog
 	dogroup := parallel.NewWaitGroup()
-	dogroup.Add(1)
-	go func() {
-		defer dogroup.Done()
-		log.Trace("Multitrack is starting...")
-		dogroup.ErrChan() <- multitrack.Multitrack(kube.Client, specs, opts)
-	}()
+	dogroup.Add(len(specs))
+	for t := range specs {
+		go func(client kubernetes.Interface, s multitrack.MultitrackSpecs) {
+			defer dogroup.Done()
+			log.Trace("Multitrack is starting...")
+			dogroup.ErrChan() <- multitrack.Multitrack(client, s, opts)
+		}(clients[t], *specs[t])
+	}
 
 	// Run helm
 	time.Sleep(kubedogConfig.StartDelay)
@@ -272,7 +302,10 @@ func (p *Plan) syncReleasesKubedog(kubedogConfig *kubedog.Config) (err error) {
 	return nil
 }
 
-func (p *Plan) kubedogSpecs() (s multitrack.MultitrackSpecs) {
+// kubedogSpecs returns kubedog specs of releases grouped by kube context.
+func (p *Plan) kubedogSpecs() map[kubeTarget]*multitrack.MultitrackSpecs {
+	res := make(map[kubeTarget]*multitrack.MultitrackSpecs)
+
 	for _, rel := range p.body.Releases {
 		manifest := kubedog.Parse([]byte(p.manifests[rel.Uniq()]))
 		spec, err := kubedog.MakeSpecs(manifest, rel.Namespace())
@@ -289,6 +322,12 @@ func (p *Plan) kubedogSpecs() (s multitrack.MultitrackSpecs) {
 			"release":      rel.Uniq(),
 		}).Trace("kubedog track resources")
 
+		t := releaseKubeTarget(rel)
+		if res[t] == nil {
+			res[t] = &multitrack.MultitrackSpecs{}
+		}
+
+		s := res[t]
 		s.Jobs = append(s.Jobs, spec.Jobs...)
 		s.Deployments = append(s.Deployments, spec.Deployments...)
 		s.DaemonSets = append(s.DaemonSets, spec.DaemonSets...)
@@ -296,5 +335,5 @@ func (p *Plan) kubedogSpecs() (s multitrack.MultitrackSpecs) {
 		s.Canaries = append(s.Canaries, spec.Canaries...)
 	}
 
-	return s
+	return res
 }
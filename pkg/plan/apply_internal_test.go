@@ -0,0 +1,60 @@
+package plan
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/stretchr/testify/suite"
+)
+
+type ApplyReportTestSuite struct {
+	suite.Suite
+}
+
+func (s *ApplyReportTestSuite) mockRelease(name string, attempts int) *MockReleaseConfig {
+	s.T().Helper()
+
+	r := &MockReleaseConfig{}
+	r.On("Name").Return(name)
+	r.On("Namespace").Return("blabla")
+	r.On("Uniq").Return()
+	r.On("Chart").Return(release.Chart{Name: "bitnami/" + name})
+	r.On("Attempts").Return(attempts)
+
+	return r
+}
+
+func (s *ApplyReportTestSuite) TestRetriedReleases() {
+	p := New(filepath.Join(s.T().TempDir(), Dir))
+
+	redis := s.mockRelease("redis", 2)
+	nginx := s.mockRelease("nginx", 1)
+	memcached := s.mockRelease("memcached", 3)
+	p.SetReleases(redis, nginx, memcached)
+
+	buf := &bytes.Buffer{}
+	p.renderApplyReport(buf, map[release.Config]error{memcached: errors.New(s.T().Name())})
+
+	s.Require().Contains(buf.String(), "bitnami/redis")
+	s.Require().Contains(buf.String(), "bitnami/memcached")
+	s.Require().Contains(buf.String(), s.T().Name())
+	s.Require().NotContains(buf.String(), "bitnami/nginx")
+}
+
+func (s *ApplyReportTestSuite) TestNothingToReport() {
+	p := New(filepath.Join(s.T().TempDir(), Dir))
+	p.SetReleases(s.mockRelease("nginx", 1))
+
+	buf := &bytes.Buffer{}
+	p.renderApplyReport(buf, map[release.Config]error{})
+
+	s.Require().Empty(buf.String())
+}
+
+func TestApplyReportTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ApplyReportTestSuite))
+}
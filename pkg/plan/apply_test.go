@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/helmwave/helmwave/pkg/release"
 	"github.com/stretchr/testify/suite"
 	helmRelease "helm.sh/helm/v3/pkg/release"
 )
@@ -60,6 +61,8 @@ func (s *ApplyTestSuite) TestApplyFailedRelease() {
 	e := errors.New(s.T().Name())
 	mockedRelease.On("Sync").Return(&helmRelease.Release{}, e)
 	mockedRelease.On("NotifyFailed").Return()
+	mockedRelease.On("Chart").Return(release.Chart{})
+	mockedRelease.On("Attempts").Return(3)
 
 	p.SetReleases(mockedRelease)
 
@@ -80,6 +83,7 @@ func (s *ApplyTestSuite) TestApply() {
 	mockedRelease.On("Uniq").Return()
 	mockedRelease.On("Sync").Return(&helmRelease.Release{}, nil)
 	mockedRelease.On("NotifySuccess").Return()
+	mockedRelease.On("Attempts").Return(1)
 
 	mockedRepo := &plan.MockRepoConfig{}
 	mockedRepo.On("Install").Return(nil)
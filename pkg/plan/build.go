@@ -5,7 +5,8 @@ import (
 )
 
 // Build plan with yml and tags/matchALL options.
-func (p *Plan) Build(yml string, tags []string, matchAll bool, templater string) error {
+// If mergeChartValues is set, default values of charts are added to merged values of releases.
+func (p *Plan) Build(yml string, tags []string, matchAll bool, templater string, mergeChartValues bool) error {
 	p.templater = templater
 
 	// Create Body
@@ -15,13 +16,33 @@ func (p *Plan) Build(yml string, tags []string, matchAll bool, templater string)
 	}
 	p.body = body
 
+	if err := p.body.Lifecycle.PreBuild.Run(log.NewEntry(log.StandardLogger()), "pre_build"); err != nil {
+		return err
+	}
+
+	// Merge project store and defaults into releases
+	for _, rel := range p.body.Releases {
+		rel.MergeStore(p.body.Store)
+		rel.SetRetryDefaults(p.body.Retry)
+		rel.SetHelmDriverDefault(p.body.HelmDriver)
+	}
+
 	// Build Releases
 	log.Info("Building releases...")
-	p.body.Releases = buildReleases(tags, p.body.Releases, matchAll)
+	p.body.Releases, err = buildReleases(tags, p.body.Releases, matchAll, p.templater)
+	if err != nil {
+		return err
+	}
 	if len(p.body.Releases) == 0 {
 		return nil
 	}
 
+	for _, rel := range p.body.Releases {
+		if err := rel.Lifecycle().PreBuild.Run(rel.Logger(), "pre_build"); err != nil {
+			return err
+		}
+	}
+
 	// Build graphs
 	log.Info("Building graphs...")
 	p.graphMD = buildGraphMD(p.body.Releases)
@@ -47,6 +68,12 @@ func (p *Plan) Build(yml string, tags []string, matchAll bool, templater string)
 		return err
 	}
 
+	// Lock chart versions
+	err = p.useLock(LockPath(yml))
+	if err != nil {
+		return err
+	}
+
 	// Build Registries
 	log.Info("Building registries...")
 	_, err = p.buildRegistries()
@@ -67,5 +94,18 @@ func (p *Plan) Build(yml string, tags []string, matchAll bool, templater string)
 		return err
 	}
 
-	return nil
+	// Merge Values
+	log.Info("Merging values...")
+	err = p.buildMergedValues(mergeChartValues)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range p.body.Releases {
+		if err := rel.Lifecycle().PostBuild.Run(rel.Logger(), "post_build"); err != nil {
+			return err
+		}
+	}
+
+	return p.body.Lifecycle.PostBuild.Run(log.NewEntry(log.StandardLogger()), "post_build")
 }
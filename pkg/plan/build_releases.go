@@ -1,15 +1,31 @@
 package plan
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/helmwave/helmwave/pkg/helper"
 	"github.com/helmwave/helmwave/pkg/release"
 	"github.com/helmwave/helmwave/pkg/release/uniqname"
 	log "github.com/sirupsen/logrus"
 )
 
-func buildReleases(tags []string, releases []release.Config, matchAll bool) (plan []release.Config) {
+// ErrDependsOnDisabled is returned when enabled release depends on disabled one.
+var ErrDependsOnDisabled = errors.New("release depends on disabled release")
+
+func buildReleases(
+	tags []string,
+	releases []release.Config,
+	matchAll bool,
+	templater string,
+) (plan []release.Config, err error) {
+	releases, err = enabledReleases(releases, templater)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(tags) == 0 {
-		return releases
+		return releases, nil
 	}
 
 	releasesMap := make(map[uniqname.UniqName]release.Config)
@@ -24,7 +40,37 @@ func buildReleases(tags []string, releases []release.Config, matchAll bool) (pla
 		}
 	}
 
-	return plan
+	return plan, nil
+}
+
+// enabledReleases drops disabled releases and checks that nobody depends on them.
+func enabledReleases(releases []release.Config, templater string) ([]release.Config, error) {
+	enabled := make([]release.Config, 0, len(releases))
+	disabled := make(map[uniqname.UniqName]bool)
+
+	for _, r := range releases {
+		ok, err := r.Enabled(templater)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // release error is already clear
+		}
+
+		if ok {
+			enabled = append(enabled, r)
+		} else {
+			r.Logger().Info("⏭ release is disabled, skipping it")
+			disabled[r.Uniq()] = true
+		}
+	}
+
+	for _, r := range enabled {
+		for _, dep := range r.DependsOn() {
+			if disabled[uniqname.UniqName(dep)] {
+				return nil, fmt.Errorf("%w: %s depends on %s", ErrDependsOnDisabled, r.Uniq(), dep)
+			}
+		}
+	}
+
+	return enabled, nil
 }
 
 func addToPlan(plan []release.Config, rel release.Config,
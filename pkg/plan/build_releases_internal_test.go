@@ -0,0 +1,51 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+)
+
+type BuildReleasesTestSuite struct {
+	suite.Suite
+}
+
+func (s *BuildReleasesTestSuite) mockRelease(name string, enabled bool, deps ...string) *MockReleaseConfig {
+	s.T().Helper()
+
+	r := &MockReleaseConfig{}
+	r.On("Name").Return(name)
+	r.On("Namespace").Return("blabla")
+	r.On("Uniq").Return()
+	r.On("Enabled", "sprig").Return(enabled, nil)
+	r.On("DependsOn").Return(deps)
+	r.On("Tags").Return([]string{})
+	r.On("Logger").Return(log.WithField("release", name))
+
+	return r
+}
+
+func (s *BuildReleasesTestSuite) TestDisabledDropped() {
+	redis := s.mockRelease("redis", true)
+	memcached := s.mockRelease("memcached", false)
+
+	res, err := buildReleases(nil, []release.Config{redis, memcached}, false, "sprig")
+	s.Require().NoError(err)
+	s.Require().Equal([]release.Config{redis}, res)
+}
+
+func (s *BuildReleasesTestSuite) TestDependsOnDisabled() {
+	app := s.mockRelease("app", true, "db@blabla")
+	db := s.mockRelease("db", false)
+
+	_, err := buildReleases(nil, []release.Config{app, db}, false, "sprig")
+	s.Require().ErrorIs(err, ErrDependsOnDisabled)
+	s.Require().ErrorContains(err, "app@blabla depends on db@blabla")
+}
+
+func TestBuildReleasesTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(BuildReleasesTestSuite))
+}
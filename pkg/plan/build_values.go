@@ -1,19 +1,25 @@
 package plan
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/helmwave/helmwave/pkg/parallel"
 	"github.com/helmwave/helmwave/pkg/release"
 	log "github.com/sirupsen/logrus"
+	"helm.sh/helm/v3/pkg/chartutil"
 )
 
 func (p *Plan) buildValues() error {
 	wg := parallel.NewWaitGroup()
 	wg.Add(len(p.body.Releases))
 
+	tplPlan := release.NewTemplatePlan(p.body.Releases, p.body.Store)
+
 	for _, rel := range p.body.Releases {
 		go func(wg *parallel.WaitGroup, rel release.Config) {
 			defer wg.Done()
-			err := rel.BuildValues(p.tmpDir, p.templater)
+			err := rel.BuildValues(p.tmpDir, p.templater, tplPlan)
 			if err != nil {
 				log.Errorf("❌ %s values: %v", rel.Uniq(), err)
 				wg.ErrChan() <- err
@@ -34,3 +40,50 @@ func (p *Plan) buildValues() error {
 
 	return wg.Wait()
 }
+
+// buildMergedValues merges all values of each release in the same way as upgrade does.
+// If mergeChartValues is set, default values of chart are merged too with the lowest priority.
+func (p *Plan) buildMergedValues(mergeChartValues bool) error {
+	wg := parallel.NewWaitGroup()
+	wg.Add(len(p.body.Releases))
+
+	mu := &sync.Mutex{}
+
+	for _, rel := range p.body.Releases {
+		go func(wg *parallel.WaitGroup, rel release.Config) {
+			defer wg.Done()
+
+			vals, err := mergedValues(rel, mergeChartValues)
+			if err != nil {
+				log.Errorf("❌ %s merged values: %v", rel.Uniq(), err)
+				wg.ErrChan() <- err
+
+				return
+			}
+
+			mu.Lock()
+			p.mergedValues[rel.Uniq()] = vals
+			mu.Unlock()
+		}(wg, rel)
+	}
+
+	return wg.Wait()
+}
+
+func mergedValues(rel release.Config, mergeChartValues bool) (map[string]interface{}, error) {
+	vals, err := rel.MergedValues()
+	if err != nil {
+		return nil, err
+	}
+
+	if !mergeChartValues {
+		return vals, nil
+	}
+
+	ch, err := rel.GetChart()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chart values of %s: %w", rel.Uniq(), err)
+	}
+
+	return chartutil.CoalesceTables(vals, ch.Values), nil
+}
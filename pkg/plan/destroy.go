@@ -8,6 +8,10 @@ import (
 
 // Destroy destroys all releases that exist in plan.
 func (p *Plan) Destroy() error { //nolint:nolintlint
+	if err := p.body.Lifecycle.PreDown.Run(log.NewEntry(log.StandardLogger()), "pre_down"); err != nil {
+		return err
+	}
+
 	wg := parallel.NewWaitGroup()
 	wg.Add(len(p.body.Releases))
 
@@ -24,5 +28,44 @@ func (p *Plan) Destroy() error { //nolint:nolintlint
 		}(wg, p.body.Releases[i])
 	}
 
-	return wg.Wait()
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+
+	if err := p.deleteNamespaces(); err != nil {
+		return err
+	}
+
+	return p.body.Lifecycle.PostDown.Run(log.NewEntry(log.StandardLogger()), "post_down")
+}
+
+// deleteNamespaces deletes namespaces of releases with delete_namespace option once all releases are uninstalled.
+// Namespace is deleted once per cluster.
+func (p *Plan) deleteNamespaces() error {
+	type clusterNamespace struct {
+		kubeTarget
+		namespace string
+	}
+
+	deleted := make(map[clusterNamespace]bool)
+
+	for _, rel := range p.body.Releases {
+		if !rel.UninstallOptions().DeleteNamespace {
+			continue
+		}
+
+		ns := clusterNamespace{kubeTarget: releaseKubeTarget(rel), namespace: rel.Namespace()}
+		if deleted[ns] {
+			continue
+		}
+		deleted[ns] = true
+
+		if err := rel.DeleteNamespace(); err != nil {
+			log.Errorf("❌ %s: %v", rel.Uniq(), err)
+
+			return err
+		}
+	}
+
+	return nil
 }
@@ -5,7 +5,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/helmwave/helmwave/pkg/hooks"
 	"github.com/helmwave/helmwave/pkg/plan"
+	"github.com/helmwave/helmwave/pkg/release"
 	"github.com/stretchr/testify/suite"
 	helmRelease "helm.sh/helm/v3/pkg/release"
 )
@@ -23,6 +25,7 @@ func (s *DestroyTestSuite) TestDestroy() {
 	mockedRelease.On("Namespace").Return("defaultblabla")
 	mockedRelease.On("Uniq").Return()
 	mockedRelease.On("Uninstall").Return(&helmRelease.UninstallReleaseResponse{}, nil)
+	mockedRelease.On("UninstallOptions").Return(release.UninstallOptions{})
 
 	p.SetReleases(mockedRelease)
 
@@ -32,6 +35,64 @@ func (s *DestroyTestSuite) TestDestroy() {
 	mockedRelease.AssertExpectations(s.T())
 }
 
+func (s *DestroyTestSuite) TestDestroyDeleteNamespace() {
+	tmpDir := s.T().TempDir()
+	p := plan.New(filepath.Join(tmpDir, plan.Dir))
+
+	newRelease := func(name string) *plan.MockReleaseConfig {
+		r := &plan.MockReleaseConfig{}
+		r.On("Name").Return(name)
+		r.On("Namespace").Return("defaultblabla")
+		r.On("KubeContext").Return("")
+		r.On("KubeConfig").Return("")
+		r.On("Uniq").Return()
+		r.On("Uninstall").Return(&helmRelease.UninstallReleaseResponse{}, nil)
+		r.On("UninstallOptions").Return(release.UninstallOptions{DeleteNamespace: true})
+
+		return r
+	}
+
+	redis := newRelease("redis")
+	redis.On("DeleteNamespace").Return(nil)
+	memcached := newRelease("memcached")
+
+	p.SetReleases(redis, memcached)
+
+	s.Require().NoError(p.Destroy())
+
+	redis.AssertExpectations(s.T())
+	memcached.AssertNotCalled(s.T(), "DeleteNamespace")
+}
+
+func (s *DestroyTestSuite) TestDestroyDeleteNamespaceMultiCluster() {
+	tmpDir := s.T().TempDir()
+	p := plan.New(filepath.Join(tmpDir, plan.Dir))
+
+	newRelease := func(name, kubeContext string) *plan.MockReleaseConfig {
+		r := &plan.MockReleaseConfig{}
+		r.On("Name").Return(name)
+		r.On("Namespace").Return("defaultblabla")
+		r.On("KubeContext").Return(kubeContext)
+		r.On("KubeConfig").Return("")
+		r.On("Uniq").Return()
+		r.On("Uninstall").Return(&helmRelease.UninstallReleaseResponse{}, nil)
+		r.On("UninstallOptions").Return(release.UninstallOptions{DeleteNamespace: true})
+		r.On("DeleteNamespace").Return(nil)
+
+		return r
+	}
+
+	prod := newRelease("redis", "prod")
+	stage := newRelease("redis", "stage")
+
+	p.SetReleases(prod, stage)
+
+	s.Require().NoError(p.Destroy())
+
+	prod.AssertCalled(s.T(), "DeleteNamespace")
+	stage.AssertCalled(s.T(), "DeleteNamespace")
+}
+
 func (s *DestroyTestSuite) TestDestroyFailedRelease() {
 	tmpDir := s.T().TempDir()
 	p := plan.New(filepath.Join(tmpDir, plan.Dir))
@@ -51,6 +112,20 @@ func (s *DestroyTestSuite) TestDestroyFailedRelease() {
 	mockedRelease.AssertExpectations(s.T())
 }
 
+func (s *DestroyTestSuite) TestDestroyPreDownHookFailed() {
+	tmpDir := s.T().TempDir()
+	p := plan.New(filepath.Join(tmpDir, plan.Dir))
+	p.NewBody().Lifecycle.PreDown = hooks.Hooks{{Cmd: "false"}}
+
+	mockedRelease := &plan.MockReleaseConfig{}
+	p.SetReleases(mockedRelease)
+
+	err := p.Destroy()
+	s.Require().ErrorIs(err, hooks.ErrHookFailed)
+
+	mockedRelease.AssertNotCalled(s.T(), "Uninstall")
+}
+
 func (s *DestroyTestSuite) TestDestroyNoReleases() {
 	tmpDir := s.T().TempDir()
 	p := plan.New(filepath.Join(tmpDir, plan.Dir))
@@ -1,11 +1,12 @@
 package plan
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
-	"github.com/databus23/helm-diff/diff"
 	"github.com/databus23/helm-diff/manifest"
 	"github.com/helmwave/helmwave/pkg/helper"
 	"github.com/helmwave/helmwave/pkg/parallel"
@@ -14,6 +15,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 	live "helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
 )
 
 var (
@@ -28,9 +30,9 @@ var (
 )
 
 // DiffPlan show diff between 2 plans.
-func (p *Plan) DiffPlan(b *Plan, showSecret bool, diffWide int) {
+func (p *Plan) DiffPlan(b *Plan, showSecret bool, diffWide int) *DiffReport {
+	report := &DiffReport{}
 	visited := make(map[uniqname.UniqName]bool)
-	k := 0
 
 	for _, rel := range append(p.body.Releases, b.body.Releases...) {
 		if visited[rel.Uniq()] {
@@ -38,53 +40,97 @@ func (p *Plan) DiffPlan(b *Plan, showSecret bool, diffWide int) {
 		}
 		visited[rel.Uniq()] = true
 
-		oldSpecs := parseManifests(b.manifests[rel.Uniq()], rel.Namespace())
-		newSpecs := parseManifests(p.manifests[rel.Uniq()], rel.Namespace())
+		rules := p.diffIgnoreRules(rel)
+		oldSpecs := parseManifests(b.manifests[rel.Uniq()], rel.Namespace(), rules...)
+		newSpecs := parseManifests(p.manifests[rel.Uniq()], rel.Namespace(), rules...)
 
-		change := diff.Manifests(oldSpecs, newSpecs, []string{}, showSecret, diffWide, log.StandardLogger().Out)
-		if !change {
-			k++
+		oldRel, newRel := b.findRelease(rel.Uniq()), p.findRelease(rel.Uniq())
+
+		rd := diffReleaseManifests(rel.Uniq(), rel.Namespace(), oldSpecs, newSpecs, showSecret, diffWide)
+		rd.Chart = chartChange(oldRel, newRel)
+		switch {
+		case oldRel == nil:
+			rd.Change = ChangeAdded
+			log.Info("🆚 🆕 ", rel.Uniq(), " is new in plan")
+		case newRel == nil:
+			rd.Change = ChangeRemoved
+			log.Warn("🆚 ", rel.Uniq(), " was found in previous plan but not affected in new")
+		case rd.Change == ChangeUnchanged && rd.Chart.Changed():
+			rd.Change = ChangeModified
+		case rd.Change == ChangeUnchanged:
 			log.Info("🆚 ❎ ", rel.Uniq(), " no changes")
 		}
+		report.add(rd)
 	}
 
-	visitedNames := make([]uniqname.UniqName, 0, len(visited))
-	for n := range visited {
-		visitedNames = append(visitedNames, n)
+	if !report.HasChanges() {
+		log.Info("🆚 🌝 Plan has no changes")
 	}
 
-	showChangesReport(p.body.Releases, visitedNames, k)
+	return report
 }
 
-// DiffLive show diff with production releases in k8s-cluster.
-func (p *Plan) DiffLive(showSecret bool, diffWide int) {
-	alive, _, err := p.GetLive()
+// findRelease returns release of plan by its uniqname or nil if plan does not have it.
+func (p *Plan) findRelease(name uniqname.UniqName) release.Config {
+	for _, rel := range p.body.Releases {
+		if rel.Uniq() == name {
+			return rel
+		}
+	}
+
+	return nil
+}
+
+// DiffLive show diff with production releases of provided revision in k8s-cluster.
+// Values decrypted with sops are masked in values diff unless showDecrypted is set.
+func (p *Plan) DiffLive(showSecret, showDecrypted bool, diffWide, revision int) (*DiffReport, error) {
+	alive, _, err := p.GetLive(revision)
 	if err != nil {
-		log.Fatalf("Something went wrong with getting releases in the kubernetes cluster: %v", err)
+		return nil, fmt.Errorf("failed to get releases in the kubernetes cluster: %w", err)
 	}
 
-	visited := make([]uniqname.UniqName, 0, len(p.body.Releases))
-	k := 0
+	report := &DiffReport{}
 	for _, rel := range p.body.Releases {
-		visited = append(visited, rel.Uniq())
-		if active, ok := alive[rel.Uniq()]; ok {
-			// I dont use manifest.ParseRelease
-			// Because Structs are different.
-			oldSpecs := parseManifests(active.Manifest, rel.Namespace())
-			newSpecs := parseManifests(p.manifests[rel.Uniq()], rel.Namespace())
-
-			change := diff.Manifests(oldSpecs, newSpecs, []string{}, showSecret, diffWide, log.StandardLogger().Out)
-			if !change {
-				k++
-				log.Info("🆚 ❎ ", rel.Uniq(), " no changes")
-			}
+		rules := p.diffIgnoreRules(rel)
+
+		active, ok := alive[rel.Uniq()]
+		if !ok {
+			rd := diffReleaseManifests(
+				rel.Uniq(),
+				rel.Namespace(),
+				nil,
+				parseManifests(p.manifests[rel.Uniq()], rel.Namespace(), rules...),
+				showSecret,
+				diffWide,
+			)
+			rd.Change = ChangeAdded
+			report.add(rd)
+
+			continue
 		}
+
+		// I dont use manifest.ParseRelease
+		// Because Structs are different.
+		oldSpecs := parseManifests(active.Manifest, rel.Namespace(), rules...)
+		newSpecs := parseManifests(p.manifests[rel.Uniq()], rel.Namespace(), rules...)
+
+		rd := diffReleaseManifests(rel.Uniq(), rel.Namespace(), oldSpecs, newSpecs, showSecret, diffWide)
+		rd.setValues(diffReleaseValues(rel, active.Config, showSecret, showDecrypted, diffWide))
+		report.add(rd)
+
+		if rd.Change == ChangeUnchanged {
+			log.Info("🆚 ❎ ", rel.Uniq(), " no changes")
+		}
+	}
+
+	if !report.HasChanges() {
+		log.Info("🆚 🌝 Plan has no changes")
 	}
 
-	showChangesReport(p.body.Releases, visited, k)
+	return report, nil
 }
 
-func parseManifests(m, ns string) map[string]*manifest.MappingResult {
+func parseManifests(m, ns string, rules ...release.DiffIgnoreRule) map[string]*manifest.MappingResult {
 	manifests := manifest.Parse(m, ns)
 
 	type annotationManifest struct {
@@ -115,29 +161,82 @@ func parseManifests(m, ns string) map[string]*manifest.MappingResult {
 		}
 	}
 
+	for k := range manifests {
+		stripIgnoredFields(manifests[k], rules)
+	}
+
 	return manifests
 }
 
-// showChangesReport help function for reporting helm-diff.
-func showChangesReport(releases []release.Config, visited []uniqname.UniqName, k int) {
-	previous := false
-	for _, rel := range releases {
-		if !rel.Uniq().In(visited) {
-			previous = true
-			log.Warn("🆚 ", rel.Uniq(), " was found in previous plan but not affected in new")
+// diffIgnoreRules returns global diff ignore rules with release ones.
+func (p *Plan) diffIgnoreRules(rel release.Config) []release.DiffIgnoreRule {
+	rules := make([]release.DiffIgnoreRule, 0, len(p.body.Diff.Ignore))
+	rules = append(rules, p.body.Diff.Ignore...)
+
+	return append(rules, rel.DiffIgnore()...)
+}
+
+// stripIgnoredFields removes fields matched by diff ignore rules from resource content.
+func stripIgnoredFields(res *manifest.MappingResult, rules []release.DiffIgnoreRule) {
+	if len(rules) == 0 {
+		return
+	}
+
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(res.Content), node); err != nil {
+		log.WithError(err).WithField("resource", res.Name).Debug("failed to decode manifest")
+
+		return
+	}
+
+	// Resource is re-encoded when any rule matches, even if nothing is stripped.
+	// Otherwise, formatting of both sides of diff may differ.
+	name := resourceName(node)
+	matched := false
+	for i := range rules {
+		if rules[i].Match(res.Kind, name) {
+			matched = true
+			rules[i].Strip(node)
 		}
 	}
 
-	if k == len(releases) && !previous {
-		log.Info("🆚 🌝 Plan has no changes")
+	if !matched {
+		return
+	}
+
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		log.WithError(err).WithField("resource", res.Name).Debug("failed to encode manifest")
+
+		return
 	}
+
+	log.WithField("resource", res.Name).Trace("diff ignore rules are applied")
+	res.Content = strings.TrimSpace(buf.String())
+}
+
+// resourceName returns metadata.name of resource.
+func resourceName(node *yaml.Node) string {
+	meta := struct {
+		Metadata struct {
+			Name string
+		}
+	}{}
+
+	if err := node.Decode(&meta); err != nil {
+		return ""
+	}
+
+	return meta.Metadata.Name
 }
 
 // GetLiveOf returns instance of deployed helm release by name.
 func (p *Plan) GetLiveOf(name uniqname.UniqName) (*live.Release, error) {
 	for _, rel := range p.body.Releases {
 		if rel.Uniq() == name {
-			r, err := rel.Get()
+			r, err := rel.Get(0)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get release %s: %w", rel.Uniq(), err)
 			}
@@ -149,8 +248,15 @@ func (p *Plan) GetLiveOf(name uniqname.UniqName) (*live.Release, error) {
 	return nil, errors.New("release 404")
 }
 
-// GetLive returns maps of releases in a k8s-cluster.
-func (p *Plan) GetLive() (found map[uniqname.UniqName]*live.Release, notFound []uniqname.UniqName, err error) {
+// isNotInstalled checks whether error means that release is not installed in k8s-cluster.
+func isNotInstalled(err error) bool {
+	return errors.Is(err, driver.ErrReleaseNotFound) || errors.Is(err, driver.ErrNoDeployedReleases)
+}
+
+// GetLive returns maps of releases of provided revision in a k8s-cluster. See release.Config Get for revision format.
+// Releases that are not installed are returned as not found, any other error fails the whole call.
+// It is intended: ignoring e.g. unauthorized or transient API errors would show installed releases as new ones.
+func (p *Plan) GetLive(revision int) (found map[uniqname.UniqName]*live.Release, notFound []uniqname.UniqName, err error) {
 	wg := parallel.NewWaitGroup()
 	wg.Add(len(p.body.Releases))
 
@@ -161,7 +267,13 @@ func (p *Plan) GetLive() (found map[uniqname.UniqName]*live.Release, notFound []
 		go func(wg *parallel.WaitGroup, mu *sync.Mutex, rel release.Config) {
 			defer wg.Done()
 
-			r, err := rel.Get()
+			r, err := rel.Get(revision)
+			if err != nil && !isNotInstalled(err) {
+				rel.Logger().WithError(err).Error("❌ can't get release from k8s")
+				wg.ErrChan() <- err
+
+				return
+			}
 
 			mu.Lock()
 			defer mu.Unlock()
@@ -0,0 +1,81 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	helmRelease "helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type DiffLiveTestSuite struct {
+	suite.Suite
+}
+
+func (s *DiffLiveTestSuite) newPlan(getErr error) *Plan {
+	s.T().Helper()
+
+	p := New(s.T().TempDir())
+
+	r := &MockReleaseConfig{}
+	r.On("Name").Return("redis")
+	r.On("Namespace").Return("blabla")
+	r.On("Uniq").Return()
+	r.On("DiffIgnore").Return([]release.DiffIgnoreRule{})
+	r.On("Logger").Return(log.WithField("release", "redis"))
+	r.On("Get", 0).Return((*helmRelease.Release)(nil), getErr)
+
+	p.SetReleases(r)
+	p.manifests[r.Uniq()] = driftManifest
+
+	return p
+}
+
+func (s *DiffLiveTestSuite) TestNotInstalled() {
+	p := s.newPlan(fmt.Errorf("failed to get release: %w", driver.ErrReleaseNotFound))
+
+	report, err := p.DiffLive(true, false, 3, 0)
+
+	s.Require().NoError(err)
+	s.Require().Len(report.Releases, 1)
+	s.Require().Equal(ChangeAdded, report.Releases[0].Change)
+}
+
+func (s *DiffLiveTestSuite) TestGetError() {
+	errCluster := errors.New("cluster is unreachable")
+	p := s.newPlan(errCluster)
+
+	report, err := p.DiffLive(true, false, 3, 0)
+
+	s.Require().ErrorIs(err, errCluster)
+	s.Require().Nil(report)
+}
+
+func (s *DiffLiveTestSuite) TestAPIErrors() {
+	errs := []error{
+		apierrors.NewUnauthorized("token has expired"),
+		apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "sh.helm.release.v1.redis.v1", nil),
+		apierrors.NewServiceUnavailable("etcdserver: leader changed"),
+		apierrors.NewTimeoutError("request timeout", 1),
+	}
+
+	for _, getErr := range errs {
+		p := s.newPlan(fmt.Errorf("failed to get release: %w", getErr))
+
+		report, err := p.DiffLive(true, false, 3, 0)
+
+		s.Require().ErrorIs(err, getErr)
+		s.Require().Nil(report)
+	}
+}
+
+func TestDiffLiveTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DiffLiveTestSuite))
+}
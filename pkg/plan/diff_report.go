@@ -0,0 +1,206 @@
+package plan
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/acarl005/stripansi"
+	"github.com/databus23/helm-diff/diff"
+	"github.com/databus23/helm-diff/manifest"
+	"github.com/helmwave/helmwave/pkg/release/uniqname"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// Change is a kind of change of release or resource.
+type Change string
+
+const (
+	// ChangeAdded is used for releases and resources that exist only in new state.
+	ChangeAdded Change = "added"
+
+	// ChangeRemoved is used for releases and resources that exist only in old state.
+	ChangeRemoved Change = "removed"
+
+	// ChangeModified is used for releases and resources that exist in both states but differ.
+	ChangeModified Change = "modified"
+
+	// ChangeUnchanged is used for releases that exist in both states and do not differ.
+	ChangeUnchanged Change = "unchanged"
+)
+
+// DiffReport is a structured result of diffing plan with something.
+type DiffReport struct {
+	Releases []*ReleaseDiff `json:"releases" yaml:"releases"`
+}
+
+// ReleaseDiff contains all changes of a single release.
+type ReleaseDiff struct {
+	Release   uniqname.UniqName `json:"release" yaml:"release"`
+	Change    Change            `json:"change" yaml:"change"`
+	Chart     *ChartChange      `json:"chart,omitempty" yaml:"chart,omitempty"`
+	Resources []*ResourceDiff   `json:"resources,omitempty" yaml:"resources,omitempty"`
+	Values    string            `json:"values,omitempty" yaml:"values,omitempty"`
+
+	// valuesText is a colored values diff.
+	valuesText string
+}
+
+// ResourceDiff contains change of a single kubernetes resource.
+type ResourceDiff struct {
+	Kind      string `json:"kind" yaml:"kind"`
+	Name      string `json:"name" yaml:"name"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	Change    Change `json:"change" yaml:"change"`
+	Diff      string `json:"diff" yaml:"diff"`
+
+	// text is a colored helm-diff output.
+	text string
+}
+
+// HasChanges returns true if any release is not unchanged.
+func (r *DiffReport) HasChanges() bool {
+	for _, rel := range r.Releases {
+		if rel.Change != ChangeUnchanged {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Render writes report to w in provided format. Text format is colored helm-diff output.
+func (r *DiffReport) Render(w io.Writer, format string) error {
+	return renderOutput(w, format, "diff", r, r.renderText)
+}
+
+func (r *DiffReport) renderText(w io.Writer) error {
+	for _, rel := range r.Releases {
+		for _, res := range rel.Resources {
+			if _, err := io.WriteString(w, res.text); err != nil {
+				return fmt.Errorf("failed to write diff of %s: %w", rel.Release, err)
+			}
+		}
+
+		if _, err := io.WriteString(w, rel.valuesText); err != nil {
+			return fmt.Errorf("failed to write values diff of %s: %w", rel.Release, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *DiffReport) add(rel *ReleaseDiff) {
+	r.Releases = append(r.Releases, rel)
+}
+
+// setValues adds values diff to release diff and marks release as modified if values differ.
+func (rd *ReleaseDiff) setValues(text, plain string) {
+	rd.valuesText = text
+	rd.Values = plain
+
+	if plain != "" && rd.Change == ChangeUnchanged {
+		rd.Change = ChangeModified
+	}
+}
+
+// diffReleaseManifests compares two parsed manifests of release and collects changes of each resource.
+func diffReleaseManifests(
+	name uniqname.UniqName,
+	ns string,
+	oldSpecs, newSpecs map[string]*manifest.MappingResult,
+	showSecret bool,
+	diffWide int,
+) *ReleaseDiff {
+	keys := make([]string, 0, len(oldSpecs)+len(newSpecs))
+	for k := range oldSpecs {
+		keys = append(keys, k)
+	}
+	for k := range newSpecs {
+		if _, ok := oldSpecs[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	rd := &ReleaseDiff{
+		Release: name,
+		Change:  ChangeUnchanged,
+	}
+
+	for _, k := range keys {
+		oldSpec, inOld := oldSpecs[k]
+		newSpec, inNew := newSpecs[k]
+
+		var change Change
+		switch {
+		case !inOld:
+			change = ChangeAdded
+		case !inNew:
+			change = ChangeRemoved
+		case oldSpec.Content != newSpec.Content:
+			change = ChangeModified
+		default:
+			continue
+		}
+
+		res := newResourceDiff(change, ns, oldSpec, newSpec)
+
+		buf := &bytes.Buffer{}
+		if !diff.Manifests(singleSpec(k, oldSpec), singleSpec(k, newSpec), []string{}, showSecret, diffWide, buf) {
+			continue
+		}
+
+		res.text = buf.String()
+		res.Diff = stripansi.Strip(res.text)
+
+		rd.Resources = append(rd.Resources, res)
+		rd.Change = ChangeModified
+	}
+
+	return rd
+}
+
+func newResourceDiff(change Change, ns string, oldSpec, newSpec *manifest.MappingResult) *ResourceDiff {
+	spec := newSpec
+	if spec == nil {
+		spec = oldSpec
+	}
+
+	res := &ResourceDiff{
+		Kind:   spec.Kind,
+		Change: change,
+	}
+
+	meta := struct {
+		Metadata struct {
+			Name      string
+			Namespace string
+		}
+	}{}
+
+	if err := yaml.Unmarshal([]byte(spec.Content), &meta); err != nil {
+		log.WithError(err).WithField("resource", spec.Name).Debug("failed to decode resource metadata")
+	}
+
+	res.Name = meta.Metadata.Name
+	res.Namespace = meta.Metadata.Namespace
+	if res.Namespace == "" {
+		res.Namespace = ns
+	}
+
+	return res
+}
+
+func singleSpec(key string, spec *manifest.MappingResult) map[string]*manifest.MappingResult {
+	if spec == nil {
+		return map[string]*manifest.MappingResult{}
+	}
+
+	// helm-diff mutates content of secrets, so we need a copy.
+	c := *spec
+
+	return map[string]*manifest.MappingResult{key: &c}
+}
@@ -0,0 +1,155 @@
+package plan
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type DiffReportTestSuite struct {
+	suite.Suite
+}
+
+const (
+	diffReportOldManifest = `---
+# Source: test/templates/cm.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+data:
+  a: b
+---
+# Source: test/templates/sa.yaml
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: sa
+`
+	diffReportNewManifest = `---
+# Source: test/templates/cm.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+data:
+  a: c
+---
+# Source: test/templates/svc.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+  namespace: other
+`
+)
+
+func (s *DiffReportTestSuite) TestResources() {
+	oldSpecs := parseManifests(diffReportOldManifest, "blabla")
+	newSpecs := parseManifests(diffReportNewManifest, "blabla")
+
+	rd := diffReleaseManifests("redis@blabla", "blabla", oldSpecs, newSpecs, true, 3)
+
+	s.Require().Equal(ChangeModified, rd.Change)
+	s.Require().Len(rd.Resources, 3)
+
+	changes := make(map[string]*ResourceDiff)
+	for _, res := range rd.Resources {
+		changes[res.Kind] = res
+	}
+
+	s.Require().Equal(ChangeModified, changes["ConfigMap"].Change)
+	s.Require().Equal("cm", changes["ConfigMap"].Name)
+	s.Require().Equal("blabla", changes["ConfigMap"].Namespace)
+	s.Require().Contains(changes["ConfigMap"].Diff, "+   a: c")
+
+	s.Require().Equal(ChangeRemoved, changes["ServiceAccount"].Change)
+	s.Require().Equal(ChangeAdded, changes["Service"].Change)
+	s.Require().Equal("other", changes["Service"].Namespace)
+}
+
+func (s *DiffReportTestSuite) TestNoChanges() {
+	specs := parseManifests(diffReportOldManifest, "blabla")
+
+	rd := diffReleaseManifests("redis@blabla", "blabla", specs, specs, true, 3)
+
+	s.Require().Equal(ChangeUnchanged, rd.Change)
+	s.Require().Empty(rd.Resources)
+
+	report := &DiffReport{}
+	report.add(rd)
+	s.Require().False(report.HasChanges())
+}
+
+func (s *DiffReportTestSuite) TestIgnoreRules() {
+	oldManifest := `---
+# Source: test/templates/cm.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+  labels:
+    helm.sh/chart: test-1.0.0
+data:
+  a: b
+`
+	newManifest := strings.Replace(oldManifest, "test-1.0.0", "test-1.0.1", 1)
+
+	rules := make([]release.DiffIgnoreRule, 1)
+	s.Require().NoError(yaml.Unmarshal([]byte(`{kind: ConfigMap, path: 'metadata.labels["helm.sh/chart"]'}`), &rules[0]))
+
+	rd := diffReleaseManifests(
+		"redis@blabla",
+		"blabla",
+		parseManifests(oldManifest, "blabla", rules...),
+		parseManifests(newManifest, "blabla", rules...),
+		true,
+		3,
+	)
+	s.Require().Equal(ChangeUnchanged, rd.Change)
+
+	rd = diffReleaseManifests(
+		"redis@blabla",
+		"blabla",
+		parseManifests(oldManifest, "blabla"),
+		parseManifests(newManifest, "blabla"),
+		true,
+		3,
+	)
+	s.Require().Equal(ChangeModified, rd.Change)
+}
+
+func (s *DiffReportTestSuite) TestRenderJSON() {
+	report := &DiffReport{}
+	report.add(diffReleaseManifests(
+		"redis@blabla",
+		"blabla",
+		nil,
+		parseManifests(diffReportNewManifest, "blabla"),
+		true,
+		3,
+	))
+
+	buf := &bytes.Buffer{}
+	s.Require().NoError(report.Render(buf, OutputJSON))
+
+	decoded := &DiffReport{}
+	s.Require().NoError(json.Unmarshal(buf.Bytes(), decoded))
+	s.Require().Len(decoded.Releases, 1)
+	s.Require().Len(decoded.Releases[0].Resources, 2)
+	s.Require().NotContains(buf.String(), "\x1b[")
+}
+
+func (s *DiffReportTestSuite) TestRenderUnknown() {
+	s.Require().ErrorIs((&DiffReport{}).Render(&bytes.Buffer{}, "xml"), ErrUnknownOutput)
+}
+
+func TestDiffReportTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DiffReportTestSuite))
+}
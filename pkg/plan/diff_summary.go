@@ -0,0 +1,155 @@
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/helmwave/helmwave/pkg/release/uniqname"
+	"github.com/olekukonko/tablewriter"
+)
+
+// ChartChange contains chart of release in both plans.
+type ChartChange struct {
+	Name       string `json:"name" yaml:"name"`
+	OldVersion string `json:"old_version,omitempty" yaml:"old_version,omitempty"`
+	NewVersion string `json:"new_version,omitempty" yaml:"new_version,omitempty"`
+}
+
+// Changed returns true if chart version differs.
+func (c *ChartChange) Changed() bool {
+	return c != nil && c.OldVersion != c.NewVersion
+}
+
+// DiffSummary is a short summary of diff report.
+type DiffSummary struct {
+	Releases  []*ReleaseSummary `json:"releases" yaml:"releases"`
+	Added     int               `json:"added" yaml:"added"`
+	Removed   int               `json:"removed" yaml:"removed"`
+	Modified  int               `json:"modified" yaml:"modified"`
+	Unchanged int               `json:"unchanged" yaml:"unchanged"`
+}
+
+// ReleaseSummary contains counts of changed resources of a single release.
+type ReleaseSummary struct {
+	Release  uniqname.UniqName `json:"release" yaml:"release"`
+	Change   Change            `json:"change" yaml:"change"`
+	Chart    *ChartChange      `json:"chart,omitempty" yaml:"chart,omitempty"`
+	Added    int               `json:"added" yaml:"added"`
+	Removed  int               `json:"removed" yaml:"removed"`
+	Modified int               `json:"modified" yaml:"modified"`
+	Values   bool              `json:"values" yaml:"values"`
+}
+
+// Summary counts changes of releases and their resources.
+func (r *DiffReport) Summary() *DiffSummary {
+	s := &DiffSummary{
+		Releases: make([]*ReleaseSummary, 0, len(r.Releases)),
+	}
+
+	for _, rel := range r.Releases {
+		rs := &ReleaseSummary{
+			Release: rel.Release,
+			Change:  rel.Change,
+			Chart:   rel.Chart,
+			Values:  rel.Values != "",
+		}
+
+		for _, res := range rel.Resources {
+			switch res.Change {
+			case ChangeAdded:
+				rs.Added++
+			case ChangeRemoved:
+				rs.Removed++
+			case ChangeModified:
+				rs.Modified++
+			case ChangeUnchanged:
+			}
+		}
+
+		switch rel.Change {
+		case ChangeAdded:
+			s.Added++
+		case ChangeRemoved:
+			s.Removed++
+		case ChangeModified:
+			s.Modified++
+		case ChangeUnchanged:
+			s.Unchanged++
+		}
+
+		s.Releases = append(s.Releases, rs)
+	}
+
+	return s
+}
+
+// Render writes summary to w as a table.
+func (s *DiffSummary) Render(w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"release", "change", "chart", "version", "added", "removed", "modified"})
+	table.SetAutoFormatHeaders(true)
+	table.SetBorder(false)
+	table.SetFooter([]string{
+		"total",
+		fmt.Sprintf("+%d -%d ~%d =%d", s.Added, s.Removed, s.Modified, s.Unchanged),
+		"", "", "", "", "",
+	})
+
+	for _, rs := range s.Releases {
+		chart, version := "", ""
+		if rs.Chart != nil {
+			chart = rs.Chart.Name
+			version = rs.Chart.NewVersion
+			if rs.Chart.Changed() {
+				version = fmt.Sprintf("%s → %s", rs.Chart.OldVersion, rs.Chart.NewVersion)
+			}
+		}
+
+		row := []string{
+			string(rs.Release),
+			string(rs.Change),
+			chart,
+			version,
+			strconv.Itoa(rs.Added),
+			strconv.Itoa(rs.Removed),
+			strconv.Itoa(rs.Modified),
+		}
+
+		table.Rich(row, []tablewriter.Colors{{}, changeColor(rs.Change), {}, {}, {}, {}, {}})
+	}
+
+	table.Render()
+}
+
+func changeColor(c Change) tablewriter.Colors {
+	switch c {
+	case ChangeAdded:
+		return tablewriter.Color(tablewriter.Bold, tablewriter.FgGreenColor)
+	case ChangeRemoved:
+		return tablewriter.Color(tablewriter.Bold, tablewriter.FgRedColor)
+	case ChangeModified:
+		return tablewriter.Color(tablewriter.Bold, tablewriter.FgYellowColor)
+	case ChangeUnchanged:
+	}
+
+	return tablewriter.Colors{}
+}
+
+// chartChange collects chart of release from both plans.
+func chartChange(oldRel, newRel release.Config) *ChartChange {
+	c := &ChartChange{}
+
+	if oldRel != nil {
+		c.Name = oldRel.Chart().Name
+		c.OldVersion = oldRel.Chart().Version
+	}
+
+	if newRel != nil {
+		c.Name = newRel.Chart().Name
+		c.NewVersion = newRel.Chart().Version
+	}
+
+	return c
+}
@@ -0,0 +1,85 @@
+package plan
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/action"
+)
+
+type DiffSummaryTestSuite struct {
+	suite.Suite
+}
+
+func (s *DiffSummaryTestSuite) mockRelease(name, version string) *MockReleaseConfig {
+	rel := &MockReleaseConfig{}
+	rel.On("Name").Return(name)
+	rel.On("Namespace").Return("blabla")
+	rel.On("Uniq").Return()
+	rel.On("DiffIgnore").Return([]release.DiffIgnoreRule{})
+	rel.On("Chart").Return(release.Chart{
+		Name:             "bitnami/" + name,
+		ChartPathOptions: action.ChartPathOptions{Version: version},
+	})
+
+	return rel
+}
+
+func (s *DiffSummaryTestSuite) TestDiffPlan() {
+	oldPlan := New(s.T().TempDir())
+	newPlan := New(s.T().TempDir())
+
+	oldRedis, newRedis := s.mockRelease("redis", "1.0.0"), s.mockRelease("redis", "1.0.1")
+	oldNginx, newNginx := s.mockRelease("nginx", "2.0.0"), s.mockRelease("nginx", "2.0.0")
+	oldMemcached := s.mockRelease("memcached", "3.0.0")
+	newPostgres := s.mockRelease("postgres", "4.0.0")
+
+	oldPlan.SetReleases(oldRedis, oldNginx, oldMemcached)
+	newPlan.SetReleases(newRedis, newNginx, newPostgres)
+
+	oldPlan.manifests[oldRedis.Uniq()] = diffReportOldManifest
+	newPlan.manifests[newRedis.Uniq()] = diffReportOldManifest
+	oldPlan.manifests[oldNginx.Uniq()] = diffReportOldManifest
+	newPlan.manifests[newNginx.Uniq()] = diffReportNewManifest
+	oldPlan.manifests[oldMemcached.Uniq()] = diffReportOldManifest
+	newPlan.manifests[newPostgres.Uniq()] = diffReportNewManifest
+
+	summary := newPlan.DiffPlan(oldPlan, true, 3).Summary()
+
+	s.Require().Equal(1, summary.Added)
+	s.Require().Equal(1, summary.Removed)
+	s.Require().Equal(2, summary.Modified)
+	s.Require().Equal(0, summary.Unchanged)
+
+	releases := make(map[string]*ReleaseSummary)
+	for _, rs := range summary.Releases {
+		releases[string(rs.Release)] = rs
+	}
+
+	s.Require().Equal(ChangeModified, releases["redis@blabla"].Change)
+	s.Require().True(releases["redis@blabla"].Chart.Changed())
+	s.Require().Equal("1.0.0", releases["redis@blabla"].Chart.OldVersion)
+	s.Require().Equal("1.0.1", releases["redis@blabla"].Chart.NewVersion)
+
+	s.Require().Equal(ChangeModified, releases["nginx@blabla"].Change)
+	s.Require().False(releases["nginx@blabla"].Chart.Changed())
+	s.Require().Equal(1, releases["nginx@blabla"].Added)
+	s.Require().Equal(1, releases["nginx@blabla"].Removed)
+	s.Require().Equal(1, releases["nginx@blabla"].Modified)
+
+	s.Require().Equal(ChangeRemoved, releases["memcached@blabla"].Change)
+	s.Require().Equal(2, releases["memcached@blabla"].Removed)
+	s.Require().Equal(ChangeAdded, releases["postgres@blabla"].Change)
+	s.Require().Equal(2, releases["postgres@blabla"].Added)
+
+	buf := &bytes.Buffer{}
+	summary.Render(buf)
+	s.Require().Contains(buf.String(), "1.0.0 → 1.0.1")
+}
+
+func TestDiffSummaryTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DiffSummaryTestSuite))
+}
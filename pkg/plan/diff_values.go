@@ -0,0 +1,195 @@
+package plan
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/acarl005/stripansi"
+	"github.com/databus23/helm-diff/diff"
+	"github.com/databus23/helm-diff/manifest"
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/helmwave/helmwave/pkg/release"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
+)
+
+// valuesDiffKind is a pseudo kind used to diff values via helm-diff.
+const valuesDiffKind = "Values"
+
+// diffReleaseValues compares user-supplied values of deployed release with planned merged values.
+// Values decrypted with sops are masked unless showDecrypted is set.
+// It returns colored and plain unified diff or empty strings if values are the same.
+func diffReleaseValues(
+	rel release.Config,
+	liveValues map[string]interface{},
+	showSecret, showDecrypted bool,
+	diffWide int,
+) (text, plain string) {
+	planValues, err := rel.MergedValues()
+	if err != nil {
+		rel.Logger().WithError(err).Warn("🆚 can't merge planned values, skipping values diff")
+
+		return "", ""
+	}
+
+	var decrypted map[string]interface{}
+	if !showDecrypted {
+		decrypted, err = decryptedValues(rel)
+		if err != nil {
+			rel.Logger().WithError(err).Warn("🆚 can't read decrypted values, skipping values diff")
+
+			return "", ""
+		}
+	}
+
+	return diffValues(fmt.Sprintf("values of %s", rel.Uniq()), liveValues, planValues, showSecret, decrypted, diffWide)
+}
+
+// decryptedValues merges values files of release that have been decrypted during build.
+func decryptedValues(rel release.Config) (map[string]interface{}, error) {
+	var files []string
+
+	for i := range rel.Values() {
+		if rel.Values()[i].IsDecrypted() {
+			files = append(files, rel.Values()[i].Get())
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	valOpts := &values.Options{ValueFiles: files}
+	vals, err := valOpts.MergeValues(getter.Providers{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge decrypted values: %w", err)
+	}
+
+	return vals, nil
+}
+
+// diffValues compares values. Keys of decrypted values are always masked, secret-like ones are masked if showSecret is not set.
+func diffValues(
+	key string,
+	oldValues, newValues map[string]interface{},
+	showSecret bool,
+	decrypted map[string]interface{},
+	diffWide int,
+) (text, plain string) {
+	if len(oldValues) == 0 && len(newValues) == 0 {
+		return "", ""
+	}
+
+	if reflect.DeepEqual(oldValues, newValues) {
+		return "", ""
+	}
+
+	if !showSecret || len(decrypted) > 0 {
+		oldRedactor := valuesRedactor{mask: "--------", secretKeys: !showSecret}
+		newRedactor := valuesRedactor{mask: "++++++++", secretKeys: !showSecret}
+		oldValues, newValues = oldRedactor.redactMap(oldValues, newValues, decrypted, false),
+			newRedactor.redactMap(newValues, oldValues, decrypted, false)
+	}
+
+	oldSpec := map[string]*manifest.MappingResult{
+		key: {Name: key, Kind: valuesDiffKind, Content: valuesContent(oldValues)},
+	}
+	newSpec := map[string]*manifest.MappingResult{
+		key: {Name: key, Kind: valuesDiffKind, Content: valuesContent(newValues)},
+	}
+
+	buf := &bytes.Buffer{}
+	if !diff.Manifests(oldSpec, newSpec, []string{}, true, diffWide, buf) {
+		return "", ""
+	}
+
+	return buf.String(), stripansi.Strip(buf.String())
+}
+
+func valuesContent(v map[string]interface{}) string {
+	if len(v) == 0 {
+		return ""
+	}
+
+	return helper.String(v)
+}
+
+// secretKeyRegexp matches keys of values that are likely to be secret.
+var secretKeyRegexp = regexp.MustCompile(`(?i)(passw(or)?d|secret|token|credential|private[-_]?key|api[-_]?key|access[-_]?key|auth)`)
+
+// valuesRedactor hides values the same way helm-diff hides secrets data.
+// Secret leaves that are equal in other values are marked as REDACTED, others are masked with mask.
+type valuesRedactor struct {
+	mask string
+
+	// secretKeys enables masking of secret-like values.
+	// Value is secret-like if its key or key of any parent matches secretKeyRegexp.
+	// Value of `name`/`value` pair (e.g. container env) is secret-like if name matches secretKeyRegexp.
+	secretKeys bool
+}
+
+// redactMap redacts values map. Every leaf present in decrypted is masked.
+func (r valuesRedactor) redactMap(values, other, decrypted map[string]interface{}, secret bool) map[string]interface{} {
+	res := make(map[string]interface{}, len(values))
+	namedSecret := r.secretKeys && isNamedSecret(values)
+
+	for k, v := range values {
+		o, found := other[k]
+		isSecret := secret ||
+			(r.secretKeys && secretKeyRegexp.MatchString(k)) ||
+			(namedSecret && k == "value")
+
+		d, isDecrypted := decrypted[k]
+		dMap, isDecryptedMap := d.(map[string]interface{})
+		if _, isMap := v.(map[string]interface{}); isDecrypted && (!isMap || !isDecryptedMap) {
+			isSecret = true
+		}
+
+		res[k] = r.redact(v, o, found, dMap, isSecret)
+	}
+
+	return res
+}
+
+func (r valuesRedactor) redact(v, o interface{}, found bool, decrypted map[string]interface{}, secret bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		oMap, _ := o.(map[string]interface{})
+
+		return r.redactMap(v, oMap, decrypted, secret)
+	case []interface{}:
+		oList, _ := o.([]interface{})
+		res := make([]interface{}, len(v))
+
+		for i := range v {
+			var oi interface{}
+			if i < len(oList) {
+				oi = oList[i]
+			}
+
+			res[i] = r.redact(v[i], oi, i < len(oList), nil, secret)
+		}
+
+		return res
+	}
+
+	if !secret {
+		return v
+	}
+
+	s := fmt.Sprint(v)
+	if found && reflect.DeepEqual(v, o) {
+		return fmt.Sprintf("REDACTED # (%d bytes)", len(s))
+	}
+
+	return fmt.Sprintf("%s # (%d bytes)", r.mask, len(s))
+}
+
+// isNamedSecret checks whether map is a named value with secret-like name, e.g. `{name: DB_PASSWORD, value: qwerty}`.
+func isNamedSecret(values map[string]interface{}) bool {
+	name, ok := values["name"].(string)
+
+	return ok && secretKeyRegexp.MatchString(name)
+}
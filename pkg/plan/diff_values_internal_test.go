@@ -0,0 +1,124 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DiffValuesTestSuite struct {
+	suite.Suite
+}
+
+func (s *DiffValuesTestSuite) TestSame() {
+	v := map[string]interface{}{"a": "b"}
+
+	text, plain := diffValues("test", v, v, true, nil, 3)
+	s.Require().Empty(text)
+	s.Require().Empty(plain)
+}
+
+func (s *DiffValuesTestSuite) TestChanged() {
+	oldValues := map[string]interface{}{
+		"image": map[string]interface{}{"tag": "1.0"},
+	}
+	newValues := map[string]interface{}{
+		"image": map[string]interface{}{"tag": "2.0"},
+	}
+
+	_, plain := diffValues("test", oldValues, newValues, true, nil, 3)
+	s.Require().Contains(plain, "-     tag: \"1.0\"")
+	s.Require().Contains(plain, "+     tag: \"2.0\"")
+}
+
+func (s *DiffValuesTestSuite) TestRedacted() {
+	oldValues := map[string]interface{}{
+		"password": "qwerty",
+		"user":     "admin",
+	}
+	newValues := map[string]interface{}{
+		"password": "123456",
+		"user":     "admin",
+	}
+
+	_, plain := diffValues("test", oldValues, newValues, false, nil, -1)
+	s.Require().NotContains(plain, "qwerty")
+	s.Require().NotContains(plain, "123456")
+	s.Require().Contains(plain, "- password: '-------- # (6 bytes)'")
+	s.Require().Contains(plain, "+ password: '++++++++ # (6 bytes)'")
+	s.Require().Contains(plain, "user: admin")
+}
+
+func (s *DiffValuesTestSuite) TestRedactedOnlySecrets() {
+	oldValues := map[string]interface{}{
+		"image":   map[string]interface{}{"tag": "1.0"},
+		"secrets": map[string]interface{}{"db": "qwerty"},
+		"apiKey":  "abc",
+	}
+	newValues := map[string]interface{}{
+		"image":   map[string]interface{}{"tag": "2.0"},
+		"secrets": map[string]interface{}{"db": "123456"},
+		"apiKey":  "abc",
+	}
+
+	_, plain := diffValues("test", oldValues, newValues, false, nil, -1)
+	s.Require().Contains(plain, "-     tag: \"1.0\"")
+	s.Require().Contains(plain, "+     tag: \"2.0\"")
+	s.Require().NotContains(plain, "qwerty")
+	s.Require().NotContains(plain, "123456")
+	s.Require().Contains(plain, "apiKey: 'REDACTED # (3 bytes)'")
+}
+
+func (s *DiffValuesTestSuite) TestRedactedList() {
+	oldValues := map[string]interface{}{
+		"env": []interface{}{
+			map[string]interface{}{"name": "DB_PASSWORD", "value": "qwerty"},
+			map[string]interface{}{"name": "DB_USER", "value": "admin"},
+		},
+		"tokens": []interface{}{"abc"},
+	}
+	newValues := map[string]interface{}{
+		"env": []interface{}{
+			map[string]interface{}{"name": "DB_PASSWORD", "value": "123456"},
+			map[string]interface{}{"name": "DB_USER", "value": "root"},
+		},
+		"tokens": []interface{}{"abc", "def"},
+	}
+
+	_, plain := diffValues("test", oldValues, newValues, false, nil, -1)
+	s.Require().NotContains(plain, "qwerty")
+	s.Require().NotContains(plain, "123456")
+	s.Require().NotContains(plain, "def")
+	s.Require().Contains(plain, "value: '-------- # (6 bytes)'")
+	s.Require().Contains(plain, "value: '++++++++ # (6 bytes)'")
+	s.Require().Contains(plain, "value: admin")
+	s.Require().Contains(plain, "value: root")
+	s.Require().Contains(plain, "- 'REDACTED # (3 bytes)'")
+}
+
+func (s *DiffValuesTestSuite) TestRedactedDecrypted() {
+	oldValues := map[string]interface{}{
+		"db":    map[string]interface{}{"user": "admin", "host": "db"},
+		"image": map[string]interface{}{"tag": "1.0"},
+	}
+	newValues := map[string]interface{}{
+		"db":    map[string]interface{}{"user": "root", "host": "db"},
+		"image": map[string]interface{}{"tag": "2.0"},
+	}
+	decrypted := map[string]interface{}{
+		"db": map[string]interface{}{"user": "root"},
+	}
+
+	_, plain := diffValues("test", oldValues, newValues, true, decrypted, -1)
+	s.Require().NotContains(plain, "admin")
+	s.Require().NotContains(plain, "root")
+	s.Require().Contains(plain, "user: '-------- # (5 bytes)'")
+	s.Require().Contains(plain, "user: '++++++++ # (4 bytes)'")
+	s.Require().Contains(plain, "host: db")
+	s.Require().Contains(plain, "tag: \"2.0\"")
+}
+
+func TestDiffValuesTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DiffValuesTestSuite))
+}
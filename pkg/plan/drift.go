@@ -0,0 +1,280 @@
+package plan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/databus23/helm-diff/manifest"
+	"github.com/helmwave/helmwave/pkg/parallel"
+	"github.com/helmwave/helmwave/pkg/release"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	live "helm.sh/helm/v3/pkg/release"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sjson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/client-go/dynamic"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// ErrDriftDetected is returned when live objects differ from planned manifests.
+var ErrDriftDetected = errors.New("🆚 drift detected")
+
+// DynamicClientFunc creates kubernetes dynamic client and REST mapper for provided kube context and kubeconfig.
+type DynamicClientFunc func(kubeContext, kubeconfig string) (dynamic.Interface, meta.RESTMapper, error)
+
+type dynamicClient struct {
+	client dynamic.Interface
+	mapper meta.RESTMapper
+}
+
+// Drift compares planned manifests with actual objects in kubernetes cluster.
+// Only fields set in plan are compared, so status and fields populated by server are ignored.
+// Resources that are planned but missing in cluster are reported as added.
+// Clients are created once per kube context of releases.
+func (p *Plan) Drift(newClient DynamicClientFunc, showSecret bool, diffWide int) (*DiffReport, error) {
+	clients := make(map[kubeTarget]dynamicClient)
+	for _, rel := range p.body.Releases {
+		t := releaseKubeTarget(rel)
+		if _, found := clients[t]; found {
+			continue
+		}
+
+		client, mapper, err := newClient(t.context, t.kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+
+		clients[t] = dynamicClient{client: client, mapper: mapper}
+	}
+
+	wg := parallel.NewWaitGroup()
+	wg.Add(len(p.body.Releases))
+
+	diffs := make([]*ReleaseDiff, len(p.body.Releases))
+
+	for i := range p.body.Releases {
+		go func(wg *parallel.WaitGroup, i int, rel release.Config) {
+			defer wg.Done()
+
+			c := clients[releaseKubeTarget(rel)]
+			oldSpecs, newSpecs, err := p.driftSpecs(c.client, c.mapper, rel)
+			if err != nil {
+				rel.Logger().WithError(err).Error("❌ can't get live objects")
+				wg.ErrChan() <- err
+
+				return
+			}
+
+			diffs[i] = diffReleaseManifests(rel.Uniq(), rel.Namespace(), oldSpecs, newSpecs, showSecret, diffWide)
+		}(wg, i, p.body.Releases[i])
+	}
+
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	report := &DiffReport{}
+	for _, rd := range diffs {
+		report.add(rd)
+
+		if rd.Change == ChangeUnchanged {
+			log.Info("🆚 ❎ ", rd.Release, " no drift")
+		} else {
+			log.Warn("🆚 ❗ ", rd.Release, " has drifted resources: ", len(rd.Resources))
+		}
+	}
+
+	return report, nil
+}
+
+// driftSpecs returns projected live objects and planned objects of release keyed the same way as helm-diff does.
+func (p *Plan) driftSpecs(
+	client dynamic.Interface,
+	mapper meta.RESTMapper,
+	rel release.Config,
+) (oldSpecs, newSpecs map[string]*manifest.MappingResult, err error) {
+	rules := p.diffIgnoreRules(rel)
+	oldSpecs = make(map[string]*manifest.MappingResult)
+	newSpecs = make(map[string]*manifest.MappingResult)
+
+	for k, spec := range parseManifests(p.manifests[rel.Uniq()], rel.Namespace()) {
+		planned, err := decodePlanned(spec.Content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode planned resource %s: %w", k, err)
+		}
+
+		if _, isHook := planned.GetAnnotations()[live.HookAnnotation]; isHook {
+			continue
+		}
+
+		actual, err := getLiveObject(client, mapper, planned, rel.Namespace())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		newSpecs[k] = driftSpec(k, spec.Kind, planned.Object, rules)
+		if actual != nil {
+			projected, _ := projectFields(actual.Object, planned.Object).(map[string]interface{})
+			oldSpecs[k] = driftSpec(k, spec.Kind, projected, rules)
+		}
+	}
+
+	return oldSpecs, newSpecs, nil
+}
+
+// decodePlanned decodes planned object the same way kubernetes clients decode live objects,
+// i.e. integers are kept as int64 instead of float64.
+func decodePlanned(content string) (*unstructured.Unstructured, error) {
+	j, err := k8syaml.YAMLToJSON([]byte(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert YAML to JSON: %w", err)
+	}
+
+	planned := &unstructured.Unstructured{}
+	if err := k8sjson.Unmarshal(j, &planned.Object); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	return planned, nil
+}
+
+// getLiveObject fetches actual object for planned one. It returns nil if object does not exist.
+func getLiveObject(
+	client dynamic.Interface,
+	mapper meta.RESTMapper,
+	planned *unstructured.Unstructured,
+	ns string,
+) (*unstructured.Unstructured, error) {
+	gvk := planned.GroupVersionKind()
+
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find resource for %s: %w", gvk, err)
+	}
+
+	var ri dynamic.ResourceInterface = client.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if planned.GetNamespace() != "" {
+			ns = planned.GetNamespace()
+		}
+		ri = client.Resource(mapping.Resource).Namespace(ns)
+	}
+
+	actual, err := ri.Get(context.Background(), planned.GetName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", gvk.Kind, planned.GetName(), err)
+	}
+
+	return actual, nil
+}
+
+// projectFields keeps only fields of live object that are set in planned object.
+// Lists are compared as a whole if their length differs.
+func projectFields(actual, planned interface{}) interface{} {
+	switch p := planned.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+
+		res := make(map[string]interface{}, len(p))
+		for k, v := range p {
+			if av, found := a[k]; found {
+				res[k] = projectFields(av, v)
+			}
+		}
+
+		return res
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(p) {
+			return actual
+		}
+
+		res := make([]interface{}, len(a))
+		for i := range a {
+			res[i] = projectFields(a[i], p[i])
+		}
+
+		return res
+	default:
+		// Server normalizes quantities, e.g. `cpu: 0.5` becomes `500m`
+		if equalQuantities(actual, planned) {
+			return planned
+		}
+
+		return actual
+	}
+}
+
+// equalQuantities checks whether both values are the same kubernetes quantity written differently.
+func equalQuantities(actual, planned interface{}) bool {
+	a, ok := toQuantity(actual)
+	if !ok {
+		return false
+	}
+
+	p, ok := toQuantity(planned)
+	if !ok {
+		return false
+	}
+
+	return a.Cmp(p) == 0
+}
+
+func toQuantity(v interface{}) (resource.Quantity, bool) {
+	var s string
+
+	switch v := v.(type) {
+	case string:
+		s = v
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return resource.Quantity{}, false
+	}
+
+	q, err := resource.ParseQuantity(s)
+
+	return q, err == nil
+}
+
+// driftSpec encodes object the same way for both sides of diff.
+func driftSpec(key, kind string, obj map[string]interface{}, rules []release.DiffIgnoreRule) *manifest.MappingResult {
+	c := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		if k != "status" {
+			c[k] = v
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		log.WithError(err).WithField("resource", key).Debug("failed to encode resource")
+	}
+
+	spec := &manifest.MappingResult{
+		Name:    key,
+		Kind:    kind,
+		Content: strings.TrimSpace(buf.String()),
+	}
+	stripIgnoredFields(spec, rules)
+
+	return spec
+}
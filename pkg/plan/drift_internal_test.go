@@ -0,0 +1,212 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/stretchr/testify/suite"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+type DriftTestSuite struct {
+	suite.Suite
+}
+
+const driftManifest = `---
+# Source: test/templates/cm.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+data:
+  a: b
+---
+# Source: test/templates/sa.yaml
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: sa
+---
+# Source: test/templates/hook.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: hook
+  annotations:
+    helm.sh/hook: pre-install
+`
+
+const driftNumbersManifest = `---
+# Source: test/templates/deploy.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: deploy
+spec:
+  replicas: 1
+  progressDeadlineSeconds: 1000680000
+  template:
+    spec:
+      containers:
+        - name: redis
+          resources:
+            limits:
+              cpu: 0.5
+              memory: 1Gi
+`
+
+func (s *DriftTestSuite) newPlan(manifest string) *Plan {
+	p := New(s.T().TempDir())
+
+	mockedRelease := &MockReleaseConfig{}
+	mockedRelease.On("Name").Return("redis")
+	mockedRelease.On("Namespace").Return("blabla")
+	mockedRelease.On("KubeContext").Return("")
+	mockedRelease.On("KubeConfig").Return("")
+	mockedRelease.On("Uniq").Return()
+	mockedRelease.On("DiffIgnore").Return([]release.DiffIgnoreRule{})
+
+	p.SetReleases(mockedRelease)
+	p.manifests[mockedRelease.Uniq()] = manifest
+
+	return p
+}
+
+func (s *DriftTestSuite) newMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{{Version: "v1"}, {Group: "apps", Version: "v1"}})
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ServiceAccount"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+
+	return mapper
+}
+
+func (s *DriftTestSuite) clients(client dynamic.Interface) DynamicClientFunc {
+	return func(_, _ string) (dynamic.Interface, meta.RESTMapper, error) {
+		return client, s.newMapper(), nil
+	}
+}
+
+func (s *DriftTestSuite) liveObject(kind, name string, fields map[string]interface{}) *unstructured.Unstructured {
+	apiVersion := "v1"
+	if kind == "Deployment" {
+		apiVersion = "apps/v1"
+	}
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":            name,
+			"namespace":       "blabla",
+			"resourceVersion": "42",
+			"labels":          map[string]interface{}{"app": "redis"},
+		},
+		"status": map[string]interface{}{"phase": "Active"},
+	}}
+
+	for k, v := range fields {
+		obj.Object[k] = v
+	}
+
+	return obj
+}
+
+func (s *DriftTestSuite) TestNoDrift() {
+	client := dynamicfake.NewSimpleDynamicClient(
+		runtime.NewScheme(),
+		s.liveObject("ConfigMap", "cm", map[string]interface{}{"data": map[string]interface{}{"a": "b"}}),
+		s.liveObject("ServiceAccount", "sa", map[string]interface{}{"secrets": []interface{}{}}),
+	)
+
+	report, err := s.newPlan(driftManifest).Drift(s.clients(client), true, 3)
+	s.Require().NoError(err)
+	s.Require().False(report.HasChanges())
+}
+
+func (s *DriftTestSuite) TestDrift() {
+	client := dynamicfake.NewSimpleDynamicClient(
+		runtime.NewScheme(),
+		s.liveObject("ConfigMap", "cm", map[string]interface{}{"data": map[string]interface{}{"a": "c"}}),
+	)
+
+	report, err := s.newPlan(driftManifest).Drift(s.clients(client), true, 3)
+	s.Require().NoError(err)
+	s.Require().True(report.HasChanges())
+	s.Require().Len(report.Releases, 1)
+
+	changes := make(map[string]*ResourceDiff)
+	for _, res := range report.Releases[0].Resources {
+		changes[res.Kind] = res
+	}
+
+	s.Require().Len(changes, 2)
+	s.Require().Equal(ChangeModified, changes["ConfigMap"].Change)
+	s.Require().Contains(changes["ConfigMap"].Diff, "+   a: b")
+	s.Require().Contains(changes["ConfigMap"].Diff, "-   a: c")
+	s.Require().Equal(ChangeAdded, changes["ServiceAccount"].Change)
+}
+
+func (s *DriftTestSuite) TestNumbersNoDrift() {
+	client := dynamicfake.NewSimpleDynamicClient(
+		runtime.NewScheme(),
+		s.liveObject("Deployment", "deploy", map[string]interface{}{"spec": map[string]interface{}{
+			"replicas":                int64(1),
+			"progressDeadlineSeconds": int64(1000680000),
+			"template": map[string]interface{}{"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{
+					"name": "redis",
+					"resources": map[string]interface{}{"limits": map[string]interface{}{
+						"cpu":    "500m",
+						"memory": "1Gi",
+					}},
+				}},
+			}},
+		}}),
+	)
+
+	report, err := s.newPlan(driftNumbersManifest).Drift(s.clients(client), true, 3)
+	s.Require().NoError(err)
+	s.Require().False(report.HasChanges())
+}
+
+func (s *DriftTestSuite) TestNumbersDrift() {
+	client := dynamicfake.NewSimpleDynamicClient(
+		runtime.NewScheme(),
+		s.liveObject("Deployment", "deploy", map[string]interface{}{"spec": map[string]interface{}{
+			"replicas":                int64(2),
+			"progressDeadlineSeconds": int64(1000680000),
+			"template": map[string]interface{}{"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{
+					"name": "redis",
+					"resources": map[string]interface{}{"limits": map[string]interface{}{
+						"cpu":    "1",
+						"memory": "1Gi",
+					}},
+				}},
+			}},
+		}}),
+	)
+
+	report, err := s.newPlan(driftNumbersManifest).Drift(s.clients(client), true, 3)
+	s.Require().NoError(err)
+	s.Require().True(report.HasChanges())
+	s.Require().Len(report.Releases, 1)
+	s.Require().Len(report.Releases[0].Resources, 1)
+
+	diff := report.Releases[0].Resources[0].Diff
+	s.Require().Contains(diff, "+   replicas: 1")
+	s.Require().Contains(diff, "-   replicas: 2")
+	s.Require().Contains(diff, "cpu: 0.5")
+	s.Require().NotContains(diff, "+   progressDeadlineSeconds")
+}
+
+func TestDriftTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DriftTestSuite))
+}
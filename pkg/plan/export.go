@@ -7,6 +7,8 @@ import (
 
 	"github.com/helmwave/helmwave/pkg/helper"
 	"github.com/helmwave/helmwave/pkg/parallel"
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/helmwave/helmwave/pkg/release/uniqname"
 	dir "github.com/otiai10/copy"
 )
 
@@ -31,7 +33,15 @@ func (p *Plan) Export() error {
 			wg.ErrChan() <- err
 		}
 
-		// Save Planfile after values
+		if err := p.exportMergedValues(); err != nil {
+			wg.ErrChan() <- err
+		}
+
+		if err := p.exportCharts(); err != nil {
+			wg.ErrChan() <- err
+		}
+
+		// Save Planfile after values and charts
 		if err := helper.SaveInterface(p.fullPath, p.body); err != nil {
 			wg.ErrChan() <- err
 		}
@@ -52,7 +62,7 @@ func (p *Plan) exportManifest() error {
 	}
 
 	for k, v := range p.manifests {
-		m := filepath.Join(p.dir, Manifest, string(k)+".yml")
+		m := filepath.Join(p.dir, Manifest, k.Path()+".yml")
 
 		f, err := helper.CreateFile(m)
 		if err != nil {
@@ -119,7 +129,12 @@ func (p *Plan) exportValues() error {
 	for i, rel := range p.body.Releases {
 		for j := range p.body.Releases[i].Values() {
 			found = true
-			p.body.Releases[i].Values()[j].SetUniq(p.dir, rel.Uniq())
+			v := &p.body.Releases[i].Values()[j]
+			v.SetUniq(p.dir, rel.Uniq())
+
+			if v.IsDecrypted() {
+				rel.Logger().WithField("values", v.Get()).Warn("🔐 decrypted values are saved to plan in plain text, keep plan directory safe")
+			}
 		}
 	}
 
@@ -147,6 +162,56 @@ func (p *Plan) exportValues() error {
 	return nil
 }
 
+func (p *Plan) exportMergedValues() error {
+	for _, rel := range p.body.Releases {
+		vals, found := p.mergedValues[rel.Uniq()]
+		if !found {
+			continue
+		}
+
+		if hasDecryptedValues(rel) {
+			rel.Logger().WithField("values", p.MergedValuesPath(rel.Uniq())).Warn("🔐 merged values contain decrypted values in plain text, keep plan directory safe")
+		}
+
+		if err := helper.SaveInterface(p.MergedValuesPath(rel.Uniq()), vals); err != nil {
+			return fmt.Errorf("failed to save merged values of %s: %w", rel.Uniq(), err)
+		}
+	}
+
+	return nil
+}
+
+func hasDecryptedValues(rel release.Config) bool {
+	for i := range rel.Values() {
+		if rel.Values()[i].IsDecrypted() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exportCharts vendors chart archives of releases, so deploy stage doesn't need repositories.
+func (p *Plan) exportCharts() error {
+	for _, rel := range p.body.Releases {
+		if err := rel.VendorChart(p.ChartPath(rel.Uniq())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ChartPath returns path to vendored chart archive of release in plan directory.
+func (p *Plan) ChartPath(name uniqname.UniqName) string {
+	return filepath.Join(p.dir, Charts, name.Path()+".tgz")
+}
+
+// MergedValuesPath returns path to merged values of release in plan directory.
+func (p *Plan) MergedValuesPath(name uniqname.UniqName) string {
+	return filepath.Join(p.dir, Values, name.Path(), MergedValues)
+}
+
 // IsExist returns true if planfile exists.
 func (p *Plan) IsExist() bool {
 	return helper.IsExists(p.fullPath)
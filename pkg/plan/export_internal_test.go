@@ -58,6 +58,24 @@ func (s *ExportTestSuite) TestValuesOneRelease() {
 	s.Require().Equal(valuesContents, contents)
 }
 
+func (s *ExportTestSuite) TestCharts() {
+	tmpDir := s.T().TempDir()
+	p := New(filepath.Join(tmpDir, Dir))
+
+	mockedRelease := &MockReleaseConfig{}
+	mockedRelease.On("Name").Return("redis")
+	mockedRelease.On("Namespace").Return("defaultblabla")
+	mockedRelease.On("Uniq").Return()
+	mockedRelease.On("VendorChart", filepath.Join(tmpDir, Dir, Charts, "redis@defaultblabla.tgz")).Return(nil)
+
+	p.body = &planBody{
+		Releases: release.Configs{mockedRelease},
+	}
+
+	s.Require().NoError(p.exportCharts())
+	mockedRelease.AssertExpectations(s.T())
+}
+
 func TestExportTestSuite(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(ExportTestSuite))
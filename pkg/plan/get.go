@@ -0,0 +1,90 @@
+package plan
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/helmwave/helmwave/pkg/helper"
+	"helm.sh/helm/v3/pkg/chartutil"
+	live "helm.sh/helm/v3/pkg/release"
+)
+
+// GetValues writes user supplied values of deployed releases to w. Computed values are written if all is set.
+// See release.Config Get for revision semantics.
+func (p *Plan) GetValues(w io.Writer, revision int, all bool, names ...string) error {
+	return p.getReleases(w, revision, names, func(r *live.Release) ([]byte, error) {
+		vals := r.Config
+		if all {
+			var err error
+			vals, err = chartutil.CoalesceValues(r.Chart, r.Config)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compute values: %w", err)
+			}
+		}
+
+		if len(vals) == 0 {
+			return []byte("{}\n"), nil
+		}
+
+		return helper.Byte(vals), nil
+	})
+}
+
+// GetReleases writes data extracted from deployed releases to w, e.g. ReleaseManifest.
+// See release.Config Get for revision semantics.
+func (p *Plan) GetReleases(w io.Writer, revision int, extract func(*live.Release) string, names ...string) error {
+	return p.getReleases(w, revision, names, func(r *live.Release) ([]byte, error) {
+		return []byte(extract(r)), nil
+	})
+}
+
+// ReleaseManifest returns manifest of release.
+func ReleaseManifest(r *live.Release) string {
+	return r.Manifest
+}
+
+// ReleaseNotes returns notes of release.
+func ReleaseNotes(r *live.Release) string {
+	if r.Info == nil {
+		return ""
+	}
+
+	return r.Info.Notes
+}
+
+// ReleaseHooks returns manifests of release hooks.
+func ReleaseHooks(r *live.Release) string {
+	var data string
+	for _, h := range r.Hooks {
+		data += fmt.Sprintf("---\n# Source: %s\n%s\n", h.Path, h.Manifest)
+	}
+
+	return data
+}
+
+// getReleases gets revision of releases from cluster and writes data extracted from them to w.
+// All releases are used if names are empty.
+func (p *Plan) getReleases(w io.Writer, revision int, names []string, extract func(*live.Release) ([]byte, error)) error {
+	releases, err := p.filterReleases(names)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range releases {
+		r, err := rel.Get(revision)
+		if err != nil {
+			return err //nolint:wrapcheck // release error is already clear
+		}
+
+		data, err := extract(r)
+		if err != nil {
+			return fmt.Errorf("failed to get %s: %w", rel.Uniq(), err)
+		}
+
+		if err := writeShowDocument(w, rel.Uniq(), data, len(releases) > 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
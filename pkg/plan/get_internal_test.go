@@ -0,0 +1,97 @@
+package plan
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/chart"
+	helmRelease "helm.sh/helm/v3/pkg/release"
+)
+
+type GetTestSuite struct {
+	suite.Suite
+}
+
+func (s *GetTestSuite) createPlan(r *helmRelease.Release, revision int, names ...string) *Plan {
+	s.T().Helper()
+
+	p := New(filepath.Join(s.T().TempDir(), Dir))
+
+	releases := make(release.Configs, 0, len(names))
+	for _, name := range names {
+		mockedRelease := &MockReleaseConfig{}
+		mockedRelease.On("Name").Return(name)
+		mockedRelease.On("Namespace").Return("blabla")
+		mockedRelease.On("Uniq").Return()
+		mockedRelease.On("Get", revision).Return(r, nil)
+
+		releases = append(releases, mockedRelease)
+	}
+
+	p.body = &planBody{Releases: releases}
+
+	return p
+}
+
+func (s *GetTestSuite) TestValues() {
+	r := &helmRelease.Release{
+		Config: map[string]interface{}{"a": "b"},
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{Name: "redis"},
+			Values:   map[string]interface{}{"a": "default", "c": "d"},
+		},
+	}
+	p := s.createPlan(r, -1, "redis")
+
+	buf := &bytes.Buffer{}
+	s.Require().NoError(p.GetValues(buf, -1, false))
+	s.Require().Equal("a: b\n", buf.String())
+
+	buf.Reset()
+	s.Require().NoError(p.GetValues(buf, -1, true, "redis@blabla"))
+	s.Require().Equal("a: b\nc: d\n", buf.String())
+}
+
+func (s *GetTestSuite) TestManifestMultipleReleases() {
+	r := &helmRelease.Release{Manifest: "kind: ConfigMap\n"}
+	p := s.createPlan(r, 0, "redis", "memcached")
+
+	buf := &bytes.Buffer{}
+	s.Require().NoError(p.GetReleases(buf, 0, ReleaseManifest))
+	s.Require().Equal(
+		"---\n# Source: redis@blabla\nkind: ConfigMap\n---\n# Source: memcached@blabla\nkind: ConfigMap\n",
+		buf.String(),
+	)
+}
+
+func (s *GetTestSuite) TestNotesAndHooks() {
+	r := &helmRelease.Release{
+		Info: &helmRelease.Info{Notes: "Hello\n"},
+		Hooks: []*helmRelease.Hook{
+			{Path: "redis/templates/test.yaml", Manifest: "kind: Pod"},
+		},
+	}
+	p := s.createPlan(r, 2, "redis")
+
+	buf := &bytes.Buffer{}
+	s.Require().NoError(p.GetReleases(buf, 2, ReleaseNotes))
+	s.Require().Equal("Hello\n", buf.String())
+
+	buf.Reset()
+	s.Require().NoError(p.GetReleases(buf, 2, ReleaseHooks))
+	s.Require().Equal("---\n# Source: redis/templates/test.yaml\nkind: Pod\n", buf.String())
+}
+
+func (s *GetTestSuite) TestNotInPlan() {
+	p := s.createPlan(&helmRelease.Release{}, 0, "redis")
+
+	s.Require().ErrorIs(p.GetReleases(&bytes.Buffer{}, 0, ReleaseManifest, "blabla@blabla"), ErrReleaseNotInPlan)
+}
+
+func TestGetTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetTestSuite))
+}
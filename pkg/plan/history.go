@@ -0,0 +1,142 @@
+package plan
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/helmwave/helmwave/pkg/release/uniqname"
+	"github.com/olekukonko/tablewriter"
+	live "helm.sh/helm/v3/pkg/release"
+)
+
+// HistoryReport contains revision history of releases.
+type HistoryReport struct {
+	Releases []*ReleaseHistory `json:"releases" yaml:"releases"`
+}
+
+// ReleaseHistory is revision history of single release.
+type ReleaseHistory struct {
+	Release   uniqname.UniqName `json:"release" yaml:"release"`
+	Revisions []Revision        `json:"revisions,omitempty" yaml:"revisions,omitempty"`
+	Error     string            `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// Revision is a single revision of release.
+type Revision struct {
+	Revision    int       `json:"revision" yaml:"revision"`
+	Updated     time.Time `json:"updated" yaml:"updated"`
+	Status      string    `json:"status" yaml:"status"`
+	Chart       string    `json:"chart" yaml:"chart"`
+	AppVersion  string    `json:"app_version" yaml:"app_version"`
+	Description string    `json:"description" yaml:"description"`
+}
+
+// History collects up to limit revisions of releases that match tags.
+// Releases that cannot be found in cluster are reported with error.
+func (p *Plan) History(limit int, tags []string, matchAll bool) *HistoryReport {
+	report := &HistoryReport{}
+
+	for _, rel := range p.body.Releases {
+		if len(tags) > 0 && !checkTagInclusion(tags, rel.Tags(), matchAll) {
+			continue
+		}
+
+		report.Releases = append(report.Releases, releaseHistory(rel, limit))
+	}
+
+	return report
+}
+
+func releaseHistory(rel release.Config, limit int) *ReleaseHistory {
+	rh := &ReleaseHistory{Release: rel.Uniq()}
+
+	revisions, err := rel.History(limit)
+	if err != nil {
+		rel.Logger().WithError(err).Warn("Failed to get history. Skipping.")
+		rh.Error = err.Error()
+
+		return rh
+	}
+
+	// Newest revision goes first
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Version > revisions[j].Version
+	})
+
+	for _, r := range revisions {
+		rh.Revisions = append(rh.Revisions, newRevision(r))
+	}
+
+	return rh
+}
+
+func newRevision(r *live.Release) Revision {
+	rev := Revision{Revision: r.Version}
+
+	if r.Info != nil {
+		rev.Updated = r.Info.LastDeployed.Time
+		rev.Status = r.Info.Status.String()
+		rev.Description = r.Info.Description
+	}
+
+	if r.Chart != nil && r.Chart.Metadata != nil {
+		rev.Chart = fmt.Sprintf("%s-%s", r.Chart.Name(), r.Chart.Metadata.Version)
+		rev.AppVersion = r.Chart.AppVersion()
+	}
+
+	return rev
+}
+
+// Render writes history report in format. Text format is a revision table per release.
+func (r *HistoryReport) Render(w io.Writer, format string) error {
+	return renderOutput(w, format, "history", r, r.renderText)
+}
+
+func (r *HistoryReport) renderText(w io.Writer) error {
+	for _, rh := range r.Releases {
+		if _, err := fmt.Fprintf(w, "\n📜 %s\n", rh.Release); err != nil {
+			return fmt.Errorf("failed to write history of %s: %w", rh.Release, err)
+		}
+
+		if rh.Error != "" {
+			if _, err := fmt.Fprintln(w, rh.Error); err != nil {
+				return fmt.Errorf("failed to write history of %s: %w", rh.Release, err)
+			}
+
+			continue
+		}
+
+		rh.table(w).Render()
+	}
+
+	return nil
+}
+
+func (rh *ReleaseHistory) table(w io.Writer) *tablewriter.Table {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"revision", "updated", "status", "chart", "app version", "description"})
+	table.SetAutoFormatHeaders(true)
+	table.SetBorder(false)
+
+	for _, rev := range rh.Revisions {
+		statusColor := tablewriter.Colors{}
+		if rev.Status == live.StatusFailed.String() {
+			statusColor = FailStatusColor
+		}
+
+		table.Rich([]string{
+			strconv.Itoa(rev.Revision),
+			rev.Updated.Format(time.ANSIC),
+			rev.Status,
+			rev.Chart,
+			rev.AppVersion,
+			rev.Description,
+		}, []tablewriter.Colors{{}, {}, statusColor, {}, {}, {}})
+	}
+
+	return table
+}
@@ -0,0 +1,88 @@
+package plan
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/chart"
+	helmRelease "helm.sh/helm/v3/pkg/release"
+)
+
+type HistoryTestSuite struct {
+	suite.Suite
+}
+
+func (s *HistoryTestSuite) mockRelease(name string, tags ...string) *MockReleaseConfig {
+	s.T().Helper()
+
+	r := &MockReleaseConfig{}
+	r.On("Name").Return(name)
+	r.On("Namespace").Return("blabla")
+	r.On("Uniq").Return()
+	r.On("Tags").Return(tags)
+	r.On("Logger").Return(log.WithField("release", name))
+
+	return r
+}
+
+func (s *HistoryTestSuite) TestHistory() {
+	p := New(filepath.Join(s.T().TempDir(), Dir))
+
+	ch := &chart.Chart{Metadata: &chart.Metadata{Name: "redis", Version: "1.2.3", AppVersion: "6.0"}}
+
+	redis := s.mockRelease("redis", "cache")
+	redis.On("History", 5).Return([]*helmRelease.Release{
+		{
+			Version: 1,
+			Chart:   ch,
+			Info:    &helmRelease.Info{Status: helmRelease.StatusSuperseded, Description: "Install complete"},
+		},
+		{
+			Version: 2,
+			Chart:   ch,
+			Info:    &helmRelease.Info{Status: helmRelease.StatusDeployed, Description: "Upgrade complete"},
+		},
+	}, nil)
+
+	memcached := s.mockRelease("memcached", "cache")
+	memcached.On("History", 5).Return([]*helmRelease.Release{}, errors.New(s.T().Name()))
+
+	db := s.mockRelease("db", "db")
+
+	p.body = &planBody{Releases: release.Configs{redis, memcached, db}}
+
+	report := p.History(5, []string{"cache"}, false)
+	s.Require().Len(report.Releases, 2)
+
+	s.Require().Equal("redis@blabla", string(report.Releases[0].Release))
+	s.Require().Len(report.Releases[0].Revisions, 2)
+	s.Require().Equal(2, report.Releases[0].Revisions[0].Revision)
+	s.Require().Equal("deployed", report.Releases[0].Revisions[0].Status)
+	s.Require().Equal("redis-1.2.3", report.Releases[0].Revisions[0].Chart)
+	s.Require().Equal("6.0", report.Releases[0].Revisions[0].AppVersion)
+	s.Require().Equal("Upgrade complete", report.Releases[0].Revisions[0].Description)
+
+	s.Require().Equal("memcached@blabla", string(report.Releases[1].Release))
+	s.Require().Equal(s.T().Name(), report.Releases[1].Error)
+
+	db.AssertNotCalled(s.T(), "History", 5)
+
+	buf := &bytes.Buffer{}
+	s.Require().NoError(report.Render(buf, OutputText))
+	s.Require().Contains(buf.String(), "redis@blabla")
+	s.Require().Contains(buf.String(), "Upgrade complete")
+
+	buf.Reset()
+	s.Require().NoError(report.Render(buf, OutputYAML))
+	s.Require().Contains(buf.String(), "app_version: \"6.0\"")
+}
+
+func TestHistoryTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(HistoryTestSuite))
+}
@@ -56,9 +56,12 @@ func (p *Plan) importManifest() error {
 			return fmt.Errorf("failed to read manifest %s: %w", f, err)
 		}
 
-		n := strings.TrimSuffix(l.Name(), filepath.Ext(l.Name())) // drop extension of file
+		n, err := uniqname.FromPath(strings.TrimSuffix(l.Name(), filepath.Ext(l.Name()))) // drop extension of file
+		if err != nil {
+			return err
+		}
 
-		p.manifests[uniqname.UniqName(n)] = string(c)
+		p.manifests[n] = string(c)
 	}
 
 	return nil
@@ -0,0 +1,19 @@
+package plan
+
+import (
+	"github.com/helmwave/helmwave/pkg/release"
+)
+
+// kubeTarget identifies kubernetes cluster of release.
+// Empty fields mean global kube context and kubeconfig.
+type kubeTarget struct {
+	context    string
+	kubeconfig string
+}
+
+func releaseKubeTarget(rel release.Config) kubeTarget {
+	return kubeTarget{
+		context:    rel.KubeContext(),
+		kubeconfig: rel.KubeConfig(),
+	}
+}
@@ -0,0 +1,58 @@
+package plan
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type KubeTestSuite struct {
+	suite.Suite
+}
+
+const kubedogManifest = `---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replicas: 1
+`
+
+func (s *KubeTestSuite) mockRelease(name, kubeContext string) *MockReleaseConfig {
+	s.T().Helper()
+
+	r := &MockReleaseConfig{}
+	r.On("Name").Return(name)
+	r.On("Namespace").Return("blabla")
+	r.On("KubeContext").Return(kubeContext)
+	r.On("KubeConfig").Return("")
+	r.On("Uniq").Return()
+
+	return r
+}
+
+func (s *KubeTestSuite) TestKubedogSpecsGroupedByContext() {
+	p := New(filepath.Join(s.T().TempDir(), Dir))
+
+	a := s.mockRelease("a", "")
+	b := s.mockRelease("b", "prod")
+	c := s.mockRelease("c", "prod")
+
+	p.SetReleases(a, b, c)
+	for _, rel := range p.body.Releases {
+		p.manifests[rel.Uniq()] = kubedogManifest
+	}
+
+	specs := p.kubedogSpecs()
+
+	s.Require().Len(specs, 2)
+	s.Require().Len(specs[kubeTarget{}].Deployments, 1)
+	s.Require().Len(specs[kubeTarget{context: "prod"}].Deployments, 2)
+}
+
+func TestKubeTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(KubeTestSuite))
+}
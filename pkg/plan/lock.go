@@ -0,0 +1,245 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/helmwave/helmwave/pkg/release/uniqname"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/helmpath"
+	"helm.sh/helm/v3/pkg/registry"
+	helmRepo "helm.sh/helm/v3/pkg/repo"
+)
+
+// LockExt is extension of lock file. Lock file is placed next to main config, e.g. helmwave.yml -> helmwave.lock.
+const LockExt = ".lock"
+
+// ErrLockMismatch is returned when locked chart differs from chart in config.
+var ErrLockMismatch = errors.New("lock file doesn't match config, run `helmwave lock --update`")
+
+// Lock contains exact chart versions resolved from version constraints.
+type Lock struct {
+	Releases []*LockedRelease `yaml:"releases"`
+}
+
+// LockedRelease is a resolved chart of release.
+type LockedRelease struct {
+	Release    uniqname.UniqName `yaml:"release"`
+	Chart      string            `yaml:"chart"`
+	Constraint string            `yaml:"constraint,omitempty"`
+	Version    string            `yaml:"version"`
+	Digest     string            `yaml:"digest,omitempty"`
+}
+
+// LockPath returns path to lock file for main config.
+func LockPath(yml string) string {
+	return strings.TrimSuffix(yml, filepath.Ext(yml)) + LockExt
+}
+
+// LoadLock reads lock file. Empty lock is returned if file does not exist.
+func LoadLock(path string) (*Lock, error) {
+	l := &Lock{}
+
+	src, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lock file %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(src, l); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal lock file %s: %w", path, err)
+	}
+
+	return l, nil
+}
+
+// Save writes lock file.
+func (l *Lock) Save(path string) error {
+	return helper.SaveInterface(path, l)
+}
+
+func (l *Lock) get(name uniqname.UniqName) *LockedRelease {
+	for _, r := range l.Releases {
+		if r.Release == name {
+			return r
+		}
+	}
+
+	return nil
+}
+
+func (l *Lock) set(locked *LockedRelease) {
+	for i, r := range l.Releases {
+		if r.Release == locked.Release {
+			l.Releases[i] = locked
+
+			return
+		}
+	}
+
+	l.Releases = append(l.Releases, locked)
+}
+
+// useLock pins chart versions from lock file if it exists. Build never creates lock file, `helmwave lock` does.
+func (p *Plan) useLock(path string) error {
+	if !helper.IsExists(path) {
+		log.WithField("file", path).Debug("🔒 lock file doesn't exist, chart versions are not locked")
+
+		return nil
+	}
+
+	log.Info("Locking chart versions...")
+
+	return p.buildLock(path, false)
+}
+
+// buildLock pins chart versions and digests of releases to lock file.
+// Charts that are not locked yet are resolved against repository index and added to lock file.
+// If update is set, lock file is rebuilt from scratch, so releases removed from config are dropped.
+// Lock file is written only if it has been changed.
+// Digests are verified against chart archives when charts are located.
+func (p *Plan) buildLock(path string, update bool) error {
+	lock, err := LoadLock(path)
+	if err != nil {
+		return err
+	}
+
+	exists := helper.IsExists(path)
+	changed := update || !exists
+	if update {
+		lock = &Lock{}
+	}
+
+	var mismatched []string
+
+	for _, rel := range p.body.Releases {
+		if !isLockable(rel) {
+			continue
+		}
+
+		l := rel.Logger().WithField("chart", rel.Chart().Name)
+
+		locked := lock.get(rel.Uniq())
+		if locked != nil && !update {
+			if locked.Chart != rel.Chart().Name || locked.Constraint != rel.Chart().Version {
+				l.WithFields(log.Fields{
+					"locked":     locked.Chart + " " + locked.Constraint,
+					"constraint": rel.Chart().Version,
+				}).Error("🔒 chart in lock file doesn't match config")
+				mismatched = append(mismatched, string(rel.Uniq()))
+
+				continue
+			}
+
+			l.WithField("version", locked.Version).Debug("🔒 using locked chart version")
+			rel.LockChart(locked.Version, locked.Digest)
+
+			continue
+		}
+
+		cv, err := resolveChartVersion(rel)
+		if err != nil {
+			return err
+		}
+
+		l.WithFields(log.Fields{
+			"constraint": rel.Chart().Version,
+			"version":    cv.Version,
+		}).Info("🔒 chart version has been resolved")
+
+		lock.set(&LockedRelease{
+			Release:    rel.Uniq(),
+			Chart:      rel.Chart().Name,
+			Constraint: rel.Chart().Version,
+			Version:    cv.Version,
+			Digest:     cv.Digest,
+		})
+		rel.LockChart(cv.Version, cv.Digest)
+		changed = true
+	}
+
+	if len(mismatched) > 0 {
+		return fmt.Errorf("%w: %s", ErrLockMismatch, strings.Join(mismatched, ", "))
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := lock.Save(path); err != nil {
+		return err
+	}
+
+	if exists {
+		log.WithField("file", path).Info("🔒 lock file has been updated")
+	} else {
+		log.WithField("file", path).Info("🔒 lock file has been created")
+	}
+
+	return nil
+}
+
+// isLockable returns true for charts from helm repositories. Local and OCI charts have no index.
+func isLockable(rel release.Config) bool {
+	return !registry.IsOCI(rel.Chart().Name) && !repoIsLocal(rel.Repo())
+}
+
+// resolveChartVersion finds the latest chart version that satisfies constraint in repository index.
+func resolveChartVersion(rel release.Config) (*helmRepo.ChartVersion, error) {
+	index := filepath.Join(helper.Helm.RepositoryCache, helmpath.CacheIndexFile(rel.Repo()))
+
+	idx, err := helmRepo.LoadIndexFile(index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load index of repository %s: %w", rel.Repo(), err)
+	}
+
+	name := strings.TrimPrefix(rel.Chart().Name, rel.Repo()+"/")
+
+	cv, err := idx.Get(name, rel.Chart().Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve chart %s %q: %w", rel.Chart().Name, rel.Chart().Version, err)
+	}
+
+	return cv, nil
+}
+
+// Lock resolves chart versions of all enabled releases in main config and writes lock file.
+// If update is set, locked versions are resolved again.
+func (p *Plan) Lock(yml, templater string, update bool) error {
+	p.templater = templater
+
+	body, err := NewBody(yml)
+	if err != nil {
+		return err
+	}
+	p.body = body
+
+	for _, rel := range p.body.Releases {
+		rel.MergeStore(p.body.Store)
+	}
+
+	p.body.Releases, err = buildReleases(nil, p.body.Releases, false, p.templater)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.buildRepositories()
+	if err != nil {
+		return err
+	}
+
+	err = SyncRepositories(p.body.Repositories)
+	if err != nil {
+		return err
+	}
+
+	return p.buildLock(LockPath(yml), update)
+}
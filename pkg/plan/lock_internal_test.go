@@ -0,0 +1,184 @@
+package plan
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/helmwave/helmwave/pkg/release/uniqname"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/helmpath"
+)
+
+const lockTestIndex = `apiVersion: v1
+entries:
+  redis:
+    - name: redis
+      version: 12.1.3
+      digest: sha256:3
+    - name: redis
+      version: 12.1.1
+      digest: sha256:1
+    - name: redis
+      version: 12.0.0
+      digest: sha256:0
+`
+
+type LockTestSuite struct {
+	suite.Suite
+}
+
+func (s *LockTestSuite) SetupTest() {
+	cache := s.T().TempDir()
+	s.Require().NoError(os.WriteFile(
+		filepath.Join(cache, helmpath.CacheIndexFile("bitnami")),
+		[]byte(lockTestIndex),
+		0o600,
+	))
+
+	old := helper.Helm.RepositoryCache
+	helper.Helm.RepositoryCache = cache
+	s.T().Cleanup(func() {
+		helper.Helm.RepositoryCache = old
+	})
+}
+
+func (s *LockTestSuite) mockRelease(constraint string) *MockReleaseConfig {
+	s.T().Helper()
+
+	r := &MockReleaseConfig{}
+	r.On("Name").Return("redis")
+	r.On("Namespace").Return("blabla")
+	r.On("Uniq").Return()
+	r.On("Repo").Return("bitnami")
+	r.On("Chart").Return(release.Chart{
+		Name:             "bitnami/redis",
+		ChartPathOptions: action.ChartPathOptions{Version: constraint},
+	})
+	r.On("Logger").Return(log.WithField("release", "redis@blabla"))
+
+	return r
+}
+
+func (s *LockTestSuite) TestResolve() {
+	path := filepath.Join(s.T().TempDir(), LockExt)
+
+	p := New(s.T().TempDir())
+	rel := s.mockRelease("~12.1")
+	rel.On("LockChart", "12.1.3", "sha256:3").Return()
+	p.body = &planBody{Releases: release.Configs{rel}}
+
+	s.Require().NoError(p.buildLock(path, false))
+	rel.AssertExpectations(s.T())
+
+	lock, err := LoadLock(path)
+	s.Require().NoError(err)
+	s.Require().Equal([]*LockedRelease{
+		{
+			Release:    "redis@blabla",
+			Chart:      "bitnami/redis",
+			Constraint: "~12.1",
+			Version:    "12.1.3",
+			Digest:     "sha256:3",
+		},
+	}, lock.Releases)
+}
+
+func (s *LockTestSuite) TestLocked() {
+	path := filepath.Join(s.T().TempDir(), LockExt)
+	src := "# keep me\nreleases:\n  - release: redis@blabla\n    chart: bitnami/redis\n    constraint: ~12.1\n    version: 12.1.1\n"
+	s.Require().NoError(os.WriteFile(path, []byte(src), 0o600))
+
+	p := New(s.T().TempDir())
+	rel := s.mockRelease("~12.1")
+	rel.On("LockChart", "12.1.1", "").Return()
+	p.body = &planBody{Releases: release.Configs{rel}}
+
+	s.Require().NoError(p.useLock(path))
+	rel.AssertExpectations(s.T())
+
+	// unchanged lock file is not rewritten
+	b, err := os.ReadFile(path)
+	s.Require().NoError(err)
+	s.Require().Equal(src, string(b))
+}
+
+func (s *LockTestSuite) TestNoLockFile() {
+	path := filepath.Join(s.T().TempDir(), LockExt)
+
+	p := New(s.T().TempDir())
+	rel := s.mockRelease("~12.1")
+	p.body = &planBody{Releases: release.Configs{rel}}
+
+	s.Require().NoError(p.useLock(path))
+	s.Require().NoFileExists(path)
+	rel.AssertNotCalled(s.T(), "LockChart", "12.1.3", "sha256:3")
+}
+
+func (s *LockTestSuite) TestUpdate() {
+	path := filepath.Join(s.T().TempDir(), LockExt)
+	s.Require().NoError((&Lock{Releases: []*LockedRelease{
+		{Release: "redis@blabla", Chart: "bitnami/redis", Constraint: "~12.1", Version: "12.1.1"},
+	}}).Save(path))
+
+	p := New(s.T().TempDir())
+	rel := s.mockRelease("~12.1")
+	rel.On("LockChart", "12.1.3", "sha256:3").Return()
+	p.body = &planBody{Releases: release.Configs{rel}}
+
+	s.Require().NoError(p.buildLock(path, true))
+	rel.AssertExpectations(s.T())
+
+	lock, err := LoadLock(path)
+	s.Require().NoError(err)
+	s.Require().Len(lock.Releases, 1)
+	s.Require().Equal("12.1.3", lock.Releases[0].Version)
+}
+
+func (s *LockTestSuite) TestUpdateDropsRemoved() {
+	path := filepath.Join(s.T().TempDir(), LockExt)
+	s.Require().NoError((&Lock{Releases: []*LockedRelease{
+		{Release: "memcached@blabla", Chart: "bitnami/memcached", Constraint: "~5", Version: "5.0.0"},
+		{Release: "redis@blabla", Chart: "bitnami/redis", Constraint: "~12.1", Version: "12.1.1"},
+	}}).Save(path))
+
+	p := New(s.T().TempDir())
+	rel := s.mockRelease("~12.1")
+	rel.On("LockChart", "12.1.3", "sha256:3").Return()
+	p.body = &planBody{Releases: release.Configs{rel}}
+
+	s.Require().NoError(p.buildLock(path, true))
+
+	lock, err := LoadLock(path)
+	s.Require().NoError(err)
+	s.Require().Len(lock.Releases, 1)
+	s.Require().Equal(uniqname.UniqName("redis@blabla"), lock.Releases[0].Release)
+}
+
+func (s *LockTestSuite) TestMismatch() {
+	path := filepath.Join(s.T().TempDir(), LockExt)
+	s.Require().NoError((&Lock{Releases: []*LockedRelease{
+		{Release: "redis@blabla", Chart: "bitnami/redis", Constraint: "~12.0", Version: "12.0.0"},
+	}}).Save(path))
+
+	p := New(s.T().TempDir())
+	rel := s.mockRelease("~12.1")
+	p.body = &planBody{Releases: release.Configs{rel}}
+
+	s.Require().ErrorIs(p.buildLock(path, false), ErrLockMismatch)
+	rel.AssertNotCalled(s.T(), "LockChart", "12.1.3", "sha256:3")
+}
+
+func (s *LockTestSuite) TestLockPath() {
+	s.Require().Equal(filepath.Join("a", "helmwave.lock"), LockPath(filepath.Join("a", "helmwave.yml")))
+}
+
+//nolint:paralleltest // changes global helm settings
+func TestLockTestSuite(t *testing.T) {
+	// t.Parallel()
+	suite.Run(t, new(LockTestSuite))
+}
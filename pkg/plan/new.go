@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/helmwave/helmwave/pkg/hooks"
 	"github.com/helmwave/helmwave/pkg/registry"
 	"github.com/helmwave/helmwave/pkg/release"
 	"github.com/helmwave/helmwave/pkg/release/uniqname"
@@ -30,6 +32,12 @@ const (
 
 	// Values is default directory for values.
 	Values = "values/"
+
+	// Charts is default directory under Dir for vendored chart archives.
+	Charts = "charts/"
+
+	// MergedValues is default file name under Values/<uniqname> for merged values of release.
+	MergedValues = "merged.yml"
 )
 
 var (
@@ -50,6 +58,8 @@ type Plan struct {
 
 	manifests map[uniqname.UniqName]string
 
+	mergedValues map[uniqname.UniqName]map[string]interface{}
+
 	graphMD string
 
 	templater string
@@ -97,6 +107,11 @@ type planBody struct {
 	Repositories repo.Configs
 	Registries   registry.Configs
 	Releases     release.Configs
+	Diff         release.DiffConfig     `yaml:"diff,omitempty"`
+	Store        map[string]interface{} `yaml:"store,omitempty"`
+	Lifecycle    hooks.Lifecycle        `yaml:"lifecycle,omitempty"`
+	HelmDriver   helper.HelmDriver      `yaml:"helm_driver,omitempty"`
+	Retry        release.RetryOptions   `yaml:",inline"`
 }
 
 func NewBody(file string) (*planBody, error) { // nolint:revive
@@ -134,6 +149,8 @@ func New(dir string) *Plan {
 		dir:       dir,
 		fullPath:  filepath.Join(dir, File),
 		manifests: make(map[uniqname.UniqName]string),
+
+		mergedValues: make(map[uniqname.UniqName]map[string]interface{}),
 	}
 
 	return plan
@@ -1,14 +1,19 @@
 package plan
 
 import (
+	"io"
 	"path/filepath"
+	"time"
 
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/helmwave/helmwave/pkg/hooks"
 	"github.com/helmwave/helmwave/pkg/release"
 	"github.com/helmwave/helmwave/pkg/release/uniqname"
 	"github.com/helmwave/helmwave/pkg/repo"
 	"github.com/helmwave/helmwave/pkg/template"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
+	"helm.sh/helm/v3/pkg/chart"
 	helm "helm.sh/helm/v3/pkg/cli"
 	helmRelease "helm.sh/helm/v3/pkg/release"
 	helmRepo "helm.sh/helm/v3/pkg/repo"
@@ -21,11 +26,23 @@ type MockReleaseConfig struct {
 func (r *MockReleaseConfig) Uniq() uniqname.UniqName {
 	r.Called()
 
-	u, _ := uniqname.Generate(r.Name(), r.Namespace())
+	u, _ := uniqname.Generate(r.Name(), r.Namespace(), "")
 
 	return u
 }
 
+func (r *MockReleaseConfig) SetRetryDefaults(o release.RetryOptions) {
+	r.Called(o)
+}
+
+func (r *MockReleaseConfig) SetHelmDriverDefault(d helper.HelmDriver) {
+	r.Called(d)
+}
+
+func (r *MockReleaseConfig) Attempts() int {
+	return r.Called().Int(0)
+}
+
 func (r *MockReleaseConfig) HandleDependencies(_ []release.Config) {
 	r.Called()
 }
@@ -56,7 +73,7 @@ func (r *MockReleaseConfig) In(a []release.Config) bool {
 	return r.Called().Bool(0)
 }
 
-func (r *MockReleaseConfig) BuildValues(dir, templater string) error {
+func (r *MockReleaseConfig) BuildValues(dir, templater string, _ *release.TemplatePlan) error {
 	args := r.Called()
 	if errReturn := args.Error(0); errReturn != nil {
 		return errReturn
@@ -80,12 +97,38 @@ func (r *MockReleaseConfig) Uninstall() (*helmRelease.UninstallReleaseResponse,
 	return args.Get(0).(*helmRelease.UninstallReleaseResponse), args.Error(1)
 }
 
-func (r *MockReleaseConfig) Get() (*helmRelease.Release, error) {
-	args := r.Called()
+func (r *MockReleaseConfig) UninstallOptions() release.UninstallOptions {
+	return r.Called().Get(0).(release.UninstallOptions)
+}
+
+func (r *MockReleaseConfig) DeleteNamespace() error {
+	return r.Called().Error(0)
+}
+
+func (r *MockReleaseConfig) Get(version int) (*helmRelease.Release, error) {
+	args := r.Called(version)
 
 	return args.Get(0).(*helmRelease.Release), args.Error(1)
 }
 
+func (r *MockReleaseConfig) GetValues() (map[string]interface{}, error) {
+	args := r.Called()
+
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+func (r *MockReleaseConfig) MergedValues() (map[string]interface{}, error) {
+	args := r.Called()
+
+	return args.Get(0).(map[string]interface{}), args.Error(1)
+}
+
+func (r *MockReleaseConfig) GetChart() (*chart.Chart, error) {
+	args := r.Called()
+
+	return args.Get(0).(*chart.Chart), args.Error(1)
+}
+
 func (r *MockReleaseConfig) List() (*helmRelease.Release, error) {
 	args := r.Called()
 
@@ -102,6 +145,18 @@ func (r *MockReleaseConfig) Status() (*helmRelease.Release, error) {
 	return args.Get(0).(*helmRelease.Release), args.Error(1)
 }
 
+func (r *MockReleaseConfig) History(limit int) ([]*helmRelease.Release, error) {
+	args := r.Called(limit)
+
+	return args.Get(0).([]*helmRelease.Release), args.Error(1)
+}
+
+func (r *MockReleaseConfig) Test(timeout time.Duration, logs io.Writer) (*helmRelease.Release, error) {
+	args := r.Called(timeout, logs)
+
+	return args.Get(0).(*helmRelease.Release), args.Error(1)
+}
+
 func (r *MockReleaseConfig) Name() string {
 	return r.Called().String(0)
 }
@@ -110,14 +165,36 @@ func (r *MockReleaseConfig) Namespace() string {
 	return r.Called().String(0)
 }
 
+func (r *MockReleaseConfig) KubeContext() string {
+	return r.Called().String(0)
+}
+
+func (r *MockReleaseConfig) KubeConfig() string {
+	return r.Called().String(0)
+}
+
 func (r *MockReleaseConfig) Chart() release.Chart {
 	return r.Called().Get(0).(release.Chart)
 }
 
+func (r *MockReleaseConfig) VendorChart(dst string) error {
+	return r.Called(dst).Error(0)
+}
+
+func (r *MockReleaseConfig) LockChart(version, digest string) {
+	r.Called(version, digest)
+}
+
 func (r *MockReleaseConfig) DependsOn() []string {
 	return r.Called().Get(0).([]string)
 }
 
+func (r *MockReleaseConfig) Enabled(templater string) (bool, error) {
+	args := r.Called(templater)
+
+	return args.Bool(0), args.Error(1)
+}
+
 func (r *MockReleaseConfig) Tags() []string {
 	return r.Called().Get(0).([]string)
 }
@@ -126,10 +203,26 @@ func (r *MockReleaseConfig) Repo() string {
 	return r.Called().String(0)
 }
 
+func (r *MockReleaseConfig) Store() map[string]interface{} {
+	return r.Called().Get(0).(map[string]interface{})
+}
+
+func (r *MockReleaseConfig) MergeStore(_ map[string]interface{}) {
+	r.Called()
+}
+
 func (r *MockReleaseConfig) Values() []release.ValuesReference {
 	return r.Called().Get(0).([]release.ValuesReference)
 }
 
+func (r *MockReleaseConfig) DiffIgnore() []release.DiffIgnoreRule {
+	return r.Called().Get(0).([]release.DiffIgnoreRule)
+}
+
+func (r *MockReleaseConfig) Lifecycle() hooks.Lifecycle {
+	return r.Called().Get(0).(hooks.Lifecycle)
+}
+
 func (r *MockReleaseConfig) Logger() *log.Entry {
 	return r.Called().Get(0).(*log.Entry)
 }
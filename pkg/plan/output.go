@@ -0,0 +1,54 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// OutputText is the default output format: human-readable text.
+	OutputText = "text"
+
+	// OutputJSON renders report as JSON document.
+	OutputJSON = "json"
+
+	// OutputYAML renders report as YAML document.
+	OutputYAML = "yaml"
+)
+
+// ErrUnknownOutput is returned for unsupported output format.
+var ErrUnknownOutput = fmt.Errorf("unknown output format, use one of: %s, %s, %s", OutputText, OutputJSON, OutputYAML)
+
+// IsTextOutput returns true if format is text. Empty format is text too.
+func IsTextOutput(format string) bool {
+	return format == OutputText || format == ""
+}
+
+// renderOutput writes report in format. Text is written by text function, other formats are encoded from report.
+func renderOutput(w io.Writer, format, name string, report interface{}, text func(io.Writer) error) error {
+	switch format {
+	case OutputText, "":
+		return text(w)
+	case OutputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return fmt.Errorf("failed to encode %s report to JSON: %w", name, err)
+		}
+
+		return nil
+	case OutputYAML:
+		enc := yaml.NewEncoder(w)
+		defer enc.Close() //nolint:errcheck // closing encoder only flushes already written document
+		if err := enc.Encode(report); err != nil {
+			return fmt.Errorf("failed to encode %s report to YAML: %w", name, err)
+		}
+
+		return nil
+	default:
+		return ErrUnknownOutput
+	}
+}
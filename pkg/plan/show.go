@@ -0,0 +1,91 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/helmwave/helmwave/pkg/release/uniqname"
+)
+
+// ErrReleaseNotInPlan is returned when requested release is not found in plan.
+var ErrReleaseNotInPlan = errors.New("release not found in plan")
+
+// ShowValues writes merged values of releases to w. All releases are shown if names are empty.
+func (p *Plan) ShowValues(w io.Writer, names ...string) error {
+	releases, err := p.filterReleases(names)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range releases {
+		data, err := os.ReadFile(p.MergedValuesPath(rel.Uniq()))
+		if err != nil {
+			return fmt.Errorf("failed to read merged values of %s: %w", rel.Uniq(), err)
+		}
+
+		if err := writeShowDocument(w, rel.Uniq(), data, len(releases) > 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ShowStore writes store of releases merged with project store to w. All releases are shown if names are empty.
+func (p *Plan) ShowStore(w io.Writer, names ...string) error {
+	releases, err := p.filterReleases(names)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range releases {
+		data := []byte("{}\n")
+		if len(rel.Store()) > 0 {
+			data = helper.Byte(rel.Store())
+		}
+
+		if err := writeShowDocument(w, rel.Uniq(), data, len(releases) > 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// filterReleases returns releases of plan by uniqnames in provided order. All releases are returned if names are empty.
+func (p *Plan) filterReleases(names []string) ([]release.Config, error) {
+	if len(names) == 0 {
+		return p.body.Releases, nil
+	}
+
+	res := make([]release.Config, 0, len(names))
+	for _, name := range names {
+		rel := p.findRelease(uniqname.UniqName(name))
+		if rel == nil {
+			return nil, fmt.Errorf("%w: %s", ErrReleaseNotInPlan, name)
+		}
+
+		res = append(res, rel)
+	}
+
+	return res, nil
+}
+
+// writeShowDocument writes data as YAML document. Header with release name is added if withHeader is set.
+func writeShowDocument(w io.Writer, name uniqname.UniqName, data []byte, withHeader bool) error {
+	if withHeader {
+		if _, err := fmt.Fprintf(w, "---\n# Source: %s\n", name); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
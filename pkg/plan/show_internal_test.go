@@ -0,0 +1,86 @@
+package plan
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/chart"
+)
+
+type ShowTestSuite struct {
+	suite.Suite
+}
+
+func (s *ShowTestSuite) TestShowValues() {
+	p := New(s.T().TempDir())
+
+	mockedRelease := &MockReleaseConfig{}
+	mockedRelease.On("Name").Return("redis")
+	mockedRelease.On("Namespace").Return("blabla")
+	mockedRelease.On("Uniq").Return()
+	mockedRelease.On("MergedValues").Return(map[string]interface{}{"a": "b"}, nil)
+	mockedRelease.On("Values").Return([]release.ValuesReference{})
+	mockedRelease.On("GetChart").Return(&chart.Chart{Values: map[string]interface{}{"a": "c", "d": "e"}}, nil)
+
+	p.SetReleases(mockedRelease)
+
+	s.Require().NoError(p.buildMergedValues(true))
+	s.Require().NoError(p.exportMergedValues())
+	s.Require().FileExists(p.MergedValuesPath("redis@blabla"))
+
+	buf := &bytes.Buffer{}
+	s.Require().NoError(p.ShowValues(buf, "redis@blabla"))
+	s.Require().Equal("a: b\nd: e\n", buf.String())
+
+	s.Require().ErrorIs(p.ShowValues(buf, "nginx@blabla"), ErrReleaseNotInPlan)
+	mockedRelease.AssertExpectations(s.T())
+}
+
+func (s *ShowTestSuite) TestWithoutChartValues() {
+	p := New(s.T().TempDir())
+
+	mockedRelease := &MockReleaseConfig{}
+	mockedRelease.On("Name").Return("redis")
+	mockedRelease.On("Namespace").Return("blabla")
+	mockedRelease.On("Uniq").Return()
+	mockedRelease.On("MergedValues").Return(map[string]interface{}{"a": "b"}, nil)
+
+	p.SetReleases(mockedRelease)
+
+	s.Require().NoError(p.buildMergedValues(false))
+	s.Require().Equal(map[string]interface{}{"a": "b"}, p.mergedValues["redis@blabla"])
+	mockedRelease.AssertNotCalled(s.T(), "GetChart")
+}
+
+func (s *ShowTestSuite) TestShowStore() {
+	p := New(s.T().TempDir())
+
+	redis := &MockReleaseConfig{}
+	redis.On("Name").Return("redis")
+	redis.On("Namespace").Return("blabla")
+	redis.On("Uniq").Return()
+	redis.On("Store").Return(map[string]interface{}{"domain": "example.com"})
+
+	nginx := &MockReleaseConfig{}
+	nginx.On("Name").Return("nginx")
+	nginx.On("Namespace").Return("blabla")
+	nginx.On("Uniq").Return()
+	nginx.On("Store").Return(map[string]interface{}{})
+
+	p.SetReleases(redis, nginx)
+
+	buf := &bytes.Buffer{}
+	s.Require().NoError(p.ShowStore(buf, "redis@blabla"))
+	s.Require().Equal("domain: example.com\n", buf.String())
+
+	buf.Reset()
+	s.Require().NoError(p.ShowStore(buf))
+	s.Require().Equal("---\n# Source: redis@blabla\ndomain: example.com\n---\n# Source: nginx@blabla\n{}\n", buf.String())
+}
+
+func TestShowTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ShowTestSuite))
+}
@@ -0,0 +1,201 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/helmwave/helmwave/pkg/release/uniqname"
+	"github.com/olekukonko/tablewriter"
+	log "github.com/sirupsen/logrus"
+	live "helm.sh/helm/v3/pkg/release"
+)
+
+// ErrTestFailed is returned when tests of some releases have failed.
+var ErrTestFailed = errors.New("tests failed")
+
+// TestStatus is result of release tests.
+type TestStatus string
+
+const (
+	// TestPassed means that all tests of release have passed.
+	TestPassed TestStatus = "passed"
+
+	// TestFailed means that tests of release have failed or couldn't run.
+	TestFailed TestStatus = "failed"
+
+	// TestSkipped means that release has not been tested because tests of its dependency have failed.
+	TestSkipped TestStatus = "skipped"
+)
+
+// TestReport contains results of release tests in order they have run.
+type TestReport struct {
+	Releases []*ReleaseTest `json:"releases" yaml:"releases"`
+}
+
+// ReleaseTest is a result of tests of single release.
+type ReleaseTest struct {
+	Release uniqname.UniqName `json:"release" yaml:"release"`
+	Status  TestStatus        `json:"status" yaml:"status"`
+	Tests   []TestHook        `json:"tests,omitempty" yaml:"tests,omitempty"`
+	Error   string            `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// TestHook is a single test hook of release.
+type TestHook struct {
+	Name  string `json:"name" yaml:"name"`
+	Phase string `json:"phase" yaml:"phase"`
+}
+
+// Test runs helm tests of releases in dependency order. All releases are tested if names are empty.
+// Release is skipped if tests of its dependency have failed. Logs of test pods are collected if logs is set.
+func (p *Plan) Test(timeout time.Duration, logs bool, names ...string) (*TestReport, error) {
+	releases, err := p.filterReleases(names)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &TestReport{}
+	failed := make(map[uniqname.UniqName]bool)
+
+	for _, rel := range testOrder(releases) {
+		rt := testRelease(rel, timeout, logs, failed)
+		if rt.Status != TestPassed {
+			failed[rel.Uniq()] = true
+		}
+
+		report.Releases = append(report.Releases, rt)
+	}
+
+	return report, nil
+}
+
+func testRelease(rel release.Config, timeout time.Duration, logs bool, failed map[uniqname.UniqName]bool) *ReleaseTest {
+	l := rel.Logger()
+	rt := &ReleaseTest{Release: rel.Uniq()}
+
+	for _, dep := range rel.DependsOn() {
+		if failed[uniqname.UniqName(dep)] {
+			l.Warnf("🧪 skipping tests, dependency %s has failed", dep)
+			rt.Status = TestSkipped
+			rt.Error = fmt.Sprintf("dependency %s has failed", dep)
+
+			return rt
+		}
+	}
+
+	var w io.WriteCloser
+	if logs {
+		w = l.WriterLevel(log.InfoLevel)
+		defer w.Close() //nolint:errcheck // closing pipe writer never fails
+	}
+
+	l.Info("🧪 running tests...")
+	r, err := rel.Test(timeout, w)
+	if r != nil {
+		rt.Tests = testHooks(r)
+	}
+
+	if err != nil {
+		l.WithError(err).Error("❌ tests failed")
+		rt.Status = TestFailed
+		rt.Error = err.Error()
+
+		return rt
+	}
+
+	l.Info("✅ tests passed")
+	rt.Status = TestPassed
+
+	return rt
+}
+
+func testHooks(r *live.Release) []TestHook {
+	var res []TestHook
+
+	for _, h := range r.Hooks {
+		for _, e := range h.Events {
+			if e == live.HookTest {
+				res = append(res, TestHook{Name: h.Name, Phase: string(h.LastRun.Phase)})
+
+				break
+			}
+		}
+	}
+
+	return res
+}
+
+// testOrder sorts releases, so dependencies go before releases that depend on them.
+func testOrder(releases []release.Config) []release.Config {
+	byName := make(map[uniqname.UniqName]release.Config, len(releases))
+	for _, rel := range releases {
+		byName[rel.Uniq()] = rel
+	}
+
+	res := make([]release.Config, 0, len(releases))
+	visited := make(map[uniqname.UniqName]bool, len(releases))
+
+	var visit func(rel release.Config)
+	visit = func(rel release.Config) {
+		if visited[rel.Uniq()] {
+			return
+		}
+		visited[rel.Uniq()] = true
+
+		for _, dep := range rel.DependsOn() {
+			if d, ok := byName[uniqname.UniqName(dep)]; ok {
+				visit(d)
+			}
+		}
+
+		res = append(res, rel)
+	}
+
+	for _, rel := range releases {
+		visit(rel)
+	}
+
+	return res
+}
+
+// HasFailures returns true if tests of any release have failed or have been skipped.
+func (r *TestReport) HasFailures() bool {
+	for _, rt := range r.Releases {
+		if rt.Status != TestPassed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Render writes test report in format. Text format is a summary table.
+func (r *TestReport) Render(w io.Writer, format string) error {
+	return renderOutput(w, format, "test", r, r.renderText)
+}
+
+func (r *TestReport) renderText(w io.Writer) error {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"release", "status", "tests", "error"})
+	table.SetAutoFormatHeaders(true)
+	table.SetBorder(false)
+
+	for _, rt := range r.Releases {
+		color := tablewriter.Color(tablewriter.Bold, tablewriter.FgGreenColor)
+		if rt.Status != TestPassed {
+			color = FailStatusColor
+		}
+
+		table.Rich(
+			[]string{string(rt.Release), string(rt.Status), fmt.Sprint(len(rt.Tests)), rt.Error},
+			[]tablewriter.Colors{{}, color, {}, {}},
+		)
+	}
+
+	table.Render()
+
+	return nil
+}
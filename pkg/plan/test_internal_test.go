@@ -0,0 +1,92 @@
+package plan
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	helmRelease "helm.sh/helm/v3/pkg/release"
+)
+
+type TestTestSuite struct {
+	suite.Suite
+}
+
+func (s *TestTestSuite) mockRelease(name string, deps ...string) *MockReleaseConfig {
+	s.T().Helper()
+
+	r := &MockReleaseConfig{}
+	r.On("Name").Return(name)
+	r.On("Namespace").Return("blabla")
+	r.On("Uniq").Return()
+	r.On("DependsOn").Return(deps)
+	r.On("Logger").Return(log.WithField("release", name))
+
+	return r
+}
+
+func (s *TestTestSuite) TestDependencyOrder() {
+	p := New(filepath.Join(s.T().TempDir(), Dir))
+
+	app := s.mockRelease("app", "db@blabla")
+	db := s.mockRelease("db")
+	cache := s.mockRelease("cache")
+
+	db.On("Test", time.Minute, nil).Return(&helmRelease.Release{}, errors.New(s.T().Name()))
+	cache.On("Test", time.Minute, nil).Return(&helmRelease.Release{
+		Hooks: []*helmRelease.Hook{
+			{
+				Name:    "cache-test",
+				Events:  []helmRelease.HookEvent{helmRelease.HookTest},
+				LastRun: helmRelease.HookExecution{Phase: helmRelease.HookPhaseSucceeded},
+			},
+			{
+				Name:   "cache-migrate",
+				Events: []helmRelease.HookEvent{helmRelease.HookPreInstall},
+			},
+		},
+	}, nil)
+
+	p.body = &planBody{Releases: release.Configs{app, db, cache}}
+
+	report, err := p.Test(time.Minute, false)
+	s.Require().NoError(err)
+	s.Require().True(report.HasFailures())
+
+	s.Require().Len(report.Releases, 3)
+	s.Require().Equal("db@blabla", string(report.Releases[0].Release))
+	s.Require().Equal(TestFailed, report.Releases[0].Status)
+	s.Require().Equal("app@blabla", string(report.Releases[1].Release))
+	s.Require().Equal(TestSkipped, report.Releases[1].Status)
+	s.Require().Equal("cache@blabla", string(report.Releases[2].Release))
+	s.Require().Equal(TestPassed, report.Releases[2].Status)
+	s.Require().Equal([]TestHook{{Name: "cache-test", Phase: "Succeeded"}}, report.Releases[2].Tests)
+
+	app.AssertNotCalled(s.T(), "Test", time.Minute, nil)
+}
+
+func (s *TestTestSuite) TestRender() {
+	report := &TestReport{Releases: []*ReleaseTest{
+		{Release: "redis@blabla", Status: TestPassed},
+	}}
+
+	buf := &bytes.Buffer{}
+	s.Require().NoError(report.Render(buf, OutputJSON))
+	s.Require().JSONEq(`{"releases":[{"release":"redis@blabla","status":"passed"}]}`, buf.String())
+
+	buf.Reset()
+	s.Require().NoError(report.Render(buf, OutputText))
+	s.Require().Contains(buf.String(), "redis@blabla")
+
+	s.Require().ErrorIs(report.Render(buf, "blabla"), ErrUnknownOutput)
+}
+
+func TestTestTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(TestTestSuite))
+}
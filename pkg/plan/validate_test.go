@@ -29,7 +29,7 @@ func (s *ValidateTestSuite) TestValidateValues() {
 	mockedRelease.On("Uniq").Return()
 	mockedRelease.On("Logger").Return(log.WithField("test", s.T().Name()))
 	v := release.ValuesReference{Src: tmpValues}
-	s.Require().NoError(v.SetViaRelease(mockedRelease, tmpDir, "sprig"))
+	s.Require().NoError(v.SetViaRelease(mockedRelease, tmpDir, "sprig", nil))
 	mockedRelease.On("Values").Return([]release.ValuesReference{v})
 
 	p.SetReleases(mockedRelease)
@@ -1,26 +1,32 @@
 package release
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/helmwave/helmwave/pkg/helper"
+	dir "github.com/otiai10/copy"
 	log "github.com/sirupsen/logrus"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
 	helm "helm.sh/helm/v3/pkg/cli"
 	"helm.sh/helm/v3/pkg/downloader"
 	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/provenance"
 )
 
-func (rel *config) GetChart() (*chart.Chart, error) {
-	// Hmm nice action bro
-	client := rel.newInstall()
+// ErrChartDigest is returned when chart archive doesn't match digest from lock file.
+var ErrChartDigest = errors.New("chart digest mismatch")
 
-	ch, err := client.ChartPathOptions.LocateChart(rel.Chart().Name, rel.Helm())
+func (rel *config) GetChart() (*chart.Chart, error) {
+	ch, err := rel.locateChart()
 	if err != nil {
-		return nil, fmt.Errorf("failed to locate chart %s: %w", rel.Chart().Name, err)
+		return nil, err
 	}
 
 	c, err := loader.Load(ch)
@@ -35,6 +41,96 @@ func (rel *config) GetChart() (*chart.Chart, error) {
 	return c, nil
 }
 
+// locateChart returns path to chart. Vendored chart archive is used if it is set, so no repository access is needed.
+// Chart archive is verified against digest pinned in lock file.
+func (rel *config) locateChart() (string, error) {
+	if rel.ChartArchiveF != "" {
+		if err := rel.verifyChartDigest(rel.ChartArchiveF); err != nil {
+			return "", err
+		}
+
+		return rel.ChartArchiveF, nil
+	}
+
+	if rel.chartPath != "" {
+		return rel.chartPath, nil
+	}
+
+	// Hmm nice action bro
+	client := rel.newInstall()
+
+	ch, err := client.ChartPathOptions.LocateChart(rel.Chart().Name, rel.Helm())
+	if err != nil {
+		return "", fmt.Errorf("failed to locate chart %s: %w", rel.Chart().Name, err)
+	}
+
+	if err := rel.verifyChartDigest(ch); err != nil {
+		return "", err
+	}
+	rel.chartPath = ch
+
+	return ch, nil
+}
+
+// VendorChart saves chart archive of release to dst and makes release use it instead of locating chart.
+// Local chart directories are packaged.
+func (rel *config) VendorChart(dst string) error {
+	src, err := rel.locateChart()
+	if err != nil {
+		return err
+	}
+
+	stat, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("failed to stat chart %s: %w", src, err)
+	}
+
+	if stat.IsDir() {
+		tmp, err := os.MkdirTemp("", "helmwave-chart-")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary directory for chart %s: %w", src, err)
+		}
+		defer os.RemoveAll(tmp) //nolint:errcheck // it's temporary directory
+
+		c, err := loader.Load(src)
+		if err != nil {
+			return fmt.Errorf("failed to load chart %s: %w", src, err)
+		}
+
+		src, err = chartutil.Save(c, tmp)
+		if err != nil {
+			return fmt.Errorf("failed to package chart %s: %w", rel.Chart().Name, err)
+		}
+	}
+
+	if err := dir.Copy(src, dst); err != nil {
+		return fmt.Errorf("failed to vendor chart %s to %s: %w", rel.Chart().Name, dst, err)
+	}
+
+	rel.ChartArchiveF = dst
+	rel.Logger().WithField("archive", dst).Debug("📦 chart has been vendored")
+
+	return nil
+}
+
+// verifyChartDigest checks that chart archive matches digest pinned in lock file.
+func (rel *config) verifyChartDigest(archive string) error {
+	if rel.Chart().Digest == "" {
+		return nil
+	}
+
+	digest, err := provenance.DigestFile(archive)
+	if err != nil {
+		return fmt.Errorf("failed to calculate digest of chart %s: %w", archive, err)
+	}
+
+	if digest != strings.TrimPrefix(rel.Chart().Digest, "sha256:") {
+		return fmt.Errorf("%w: %s has %s, expected %s", ErrChartDigest, rel.Chart().Name, digest, rel.Chart().Digest)
+	}
+
+	return nil
+}
+
 func (rel *config) chartCheck(ch *chart.Chart) error {
 	if req := ch.Metadata.Dependencies; req != nil {
 		if err := action.CheckDependencies(ch, req); err != nil {
@@ -0,0 +1,78 @@
+package release
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/provenance"
+)
+
+type ChartVendorTestSuite struct {
+	suite.Suite
+}
+
+func (s *ChartVendorTestSuite) TestVendorLocalChart() {
+	tmpDir := s.T().TempDir()
+
+	src, err := chartutil.Create("blabla", tmpDir)
+	s.Require().NoError(err)
+
+	rel := NewConfig()
+	rel.ChartF.Name = src
+
+	dst := filepath.Join(tmpDir, "charts", "blabla@test.tgz")
+	s.Require().NoError(rel.VendorChart(dst))
+	s.Require().FileExists(dst)
+	s.Require().Equal(dst, rel.ChartArchiveF)
+
+	// Vendored archive is used, source is not needed anymore
+	s.Require().NoError(os.RemoveAll(src))
+	rel.chartPath = ""
+
+	c, err := rel.GetChart()
+	s.Require().NoError(err)
+	s.Require().Equal("blabla", c.Name())
+}
+
+func (s *ChartVendorTestSuite) TestVendorDigest() {
+	tmpDir := s.T().TempDir()
+
+	src, err := chartutil.Create("blabla", tmpDir)
+	s.Require().NoError(err)
+
+	c, err := loader.Load(src)
+	s.Require().NoError(err)
+
+	archive, err := chartutil.Save(c, tmpDir)
+	s.Require().NoError(err)
+
+	digest, err := provenance.DigestFile(archive)
+	s.Require().NoError(err)
+
+	rel := NewConfig()
+	rel.ChartArchiveF = archive
+	rel.ChartF.Name = "blabla"
+
+	rel.LockChart("0.1.0", "sha256:blabla")
+	s.Require().ErrorIs(rel.VendorChart(filepath.Join(tmpDir, "bad.tgz")), ErrChartDigest)
+	s.Require().NoFileExists(filepath.Join(tmpDir, "bad.tgz"))
+
+	_, err = rel.GetChart()
+	s.Require().ErrorIs(err, ErrChartDigest)
+
+	rel.LockChart("0.1.0", digest)
+	s.Require().NoError(rel.VendorChart(filepath.Join(tmpDir, "good.tgz")))
+	s.Require().FileExists(filepath.Join(tmpDir, "good.tgz"))
+
+	_, err = rel.GetChart()
+	s.Require().NoError(err)
+}
+
+func TestChartVendorTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ChartVendorTestSuite))
+}
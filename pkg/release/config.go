@@ -4,6 +4,8 @@ import (
 	"errors"
 	"time"
 
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/helmwave/helmwave/pkg/hooks"
 	"github.com/helmwave/helmwave/pkg/pubsub"
 	"github.com/helmwave/helmwave/pkg/release/uniqname"
 	log "github.com/sirupsen/logrus"
@@ -33,17 +35,34 @@ type config struct {
 	dependencies             map[uniqname.UniqName]<-chan pubsub.ReleaseStatus `yaml:"-"`
 	helm                     *helm.EnvSettings                                 `yaml:"-"`
 	log                      *log.Entry                                        `yaml:"-"`
-	Store                    map[string]interface{}                            `yaml:"store,omitempty"`
+	StoreF                   map[string]interface{}                            `yaml:"store,omitempty"`
 	ChartF                   Chart                                             `yaml:"chart,omitempty"`
+	ChartArchiveF            string                                            `yaml:"chart_archive,omitempty"`
+	chartPath                string                                            `yaml:"-"`
 	uniqName                 uniqname.UniqName                                 `yaml:"-"`
 	NameF                    string                                            `yaml:"name,omitempty"`
 	NamespaceF               string                                            `yaml:"namespace,omitempty"`
+	KubeContextF             string                                            `yaml:"context,omitempty"`
+	KubeConfigF              string                                            `yaml:"kubeconfig,omitempty"`
+	HelmDriverF              helper.HelmDriver                                 `yaml:"helm_driver,omitempty"`
 	DescriptionF             string                                            `yaml:"description,omitempty"`
 	DependsOnF               []string                                          `yaml:"depends_on,omitempty"`
+	EnabledF                 Enabled                                           `yaml:"enabled,omitempty"`
 	ValuesF                  []ValuesReference                                 `yaml:"values,omitempty"`
+	SetF                     map[string]string                                 `yaml:"set,omitempty"`
+	SetStringF               map[string]string                                 `yaml:"set_string,omitempty"`
+	SetFileF                 map[string]string                                 `yaml:"set_file,omitempty"` // precedence: values < set < set_string < set_file
 	TagsF                    []string                                          `yaml:"tags,omitempty"`
+	DiffF                    DiffConfig                                        `yaml:"diff,omitempty"`
+	PostRendererF            PostRendererConfig                                `yaml:"post_renderer,omitempty"`
+	LifecycleF               hooks.Lifecycle                                   `yaml:"lifecycle,omitempty"`
+	UninstallF               UninstallOptions                                  `yaml:"uninstall,omitempty"`
 	Timeout                  time.Duration                                     `yaml:"timeout,omitempty"`
 	MaxHistory               int                                               `yaml:"max_history,omitempty"`
+	RecoverPending           string                                            `yaml:"recover_pending,omitempty"`
+	Retries                  int                                               `yaml:"retries,omitempty"`
+	RetryBackoff             time.Duration                                     `yaml:"retry_backoff,omitempty"`
+	attempts                 int                                               `yaml:"-"`
 	AllowFailure             bool                                              `yaml:"allow_failure,omitempty"`
 	Atomic                   bool                                              `yaml:"atomic,omitempty"`
 	CleanupOnFail            bool                                              `yaml:"cleanup_on_fail,omitempty"`
@@ -70,6 +89,7 @@ func (rel *config) DryRun(b bool) {
 type Chart struct {
 	action.ChartPathOptions `yaml:",inline"` //nolint:nolintlint
 	Name                    string
+	Digest                  string `yaml:"digest,omitempty"`
 }
 
 func (rel *config) newInstall() *action.Install {
@@ -169,15 +189,16 @@ var (
 	ErrDepFailed = errors.New("dependency failed")
 )
 
-// Uniq redis@my-namespace.
+// Uniq redis@my-namespace or redis@my-namespace@my-context.
 func (rel *config) Uniq() uniqname.UniqName {
 	if rel.uniqName == "" {
 		var err error
-		rel.uniqName, err = uniqname.Generate(rel.Name(), rel.Namespace())
+		rel.uniqName, err = uniqname.Generate(rel.Name(), rel.Namespace(), rel.KubeContext())
 		if err != nil {
 			rel.Logger().WithFields(log.Fields{
 				"name":       rel.Name(),
 				"namespace":  rel.Namespace(),
+				"context":    rel.KubeContext(),
 				log.ErrorKey: err,
 			}).Error("failed to generate valid uniqname")
 		}
@@ -205,6 +226,23 @@ func (rel *config) Namespace() string {
 	return rel.NamespaceF
 }
 
+// KubeContext returns kube context of release. Empty string means global kube context.
+func (rel *config) KubeContext() string {
+	return rel.KubeContextF
+}
+
+// KubeConfig returns path to kubeconfig of release. Empty string means global kubeconfig.
+func (rel *config) KubeConfig() string {
+	return rel.KubeConfigF
+}
+
+// SetHelmDriverDefault sets helm driver if it is not set for release.
+func (rel *config) SetHelmDriverDefault(d helper.HelmDriver) {
+	if rel.HelmDriverF.Name == "" {
+		rel.HelmDriverF = d
+	}
+}
+
 func (rel *config) Description() string {
 	return rel.DescriptionF
 }
@@ -213,6 +251,13 @@ func (rel *config) Chart() Chart {
 	return rel.ChartF
 }
 
+// LockChart pins chart version and digest of chart archive, e.g. resolved from lock file.
+// Empty digest disables verification of chart archive.
+func (rel *config) LockChart(version, digest string) {
+	rel.ChartF.Version = version
+	rel.ChartF.Digest = digest
+}
+
 func (rel *config) DependsOn() []string {
 	return rel.DependsOnF
 }
@@ -221,10 +266,22 @@ func (rel *config) Tags() []string {
 	return rel.TagsF
 }
 
+func (rel *config) Store() map[string]interface{} {
+	return rel.StoreF
+}
+
 func (rel *config) Values() []ValuesReference {
 	return rel.ValuesF
 }
 
+func (rel *config) DiffIgnore() []DiffIgnoreRule {
+	return rel.DiffF.Ignore
+}
+
+func (rel *config) Lifecycle() hooks.Lifecycle {
+	return rel.LifecycleF
+}
+
 func (rel *config) Logger() *log.Entry {
 	if rel.log == nil {
 		rel.log = log.WithField("release", rel.Uniq())
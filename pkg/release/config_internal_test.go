@@ -3,6 +3,7 @@ package release
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -56,6 +57,25 @@ func (s *ConfigInternalTestSuite) TestConfigHelmTypeFields() {
 	}
 }
 
+func (s *ConfigInternalTestSuite) TestNewUninstall() {
+	r := NewConfig()
+	r.Timeout = time.Minute
+	r.UninstallF = UninstallOptions{
+		KeepHistory:  true,
+		DisableHooks: true,
+		Wait:         true,
+	}
+
+	c := r.newUninstall()
+	s.Require().True(c.KeepHistory)
+	s.Require().True(c.DisableHooks)
+	s.Require().True(c.Wait)
+	s.Require().Equal(time.Minute, c.Timeout)
+
+	r.UninstallF.Timeout = time.Second
+	s.Require().Equal(time.Second, r.newUninstall().Timeout)
+}
+
 func TestConfigInternalTestSuite(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(ConfigInternalTestSuite))
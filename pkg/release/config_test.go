@@ -3,8 +3,11 @@ package release_test
 import (
 	"testing"
 
+	"github.com/helmwave/helmwave/pkg/helper"
 	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/helmwave/helmwave/pkg/release/uniqname"
 	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/storage/driver"
 )
 
 type ConfigTestSuite struct {
@@ -19,6 +22,28 @@ func (s *ConfigTestSuite) TestConfigUniq() {
 	s.Require().NoError(r.Uniq().Validate())
 }
 
+func (s *ConfigTestSuite) TestConfigUniqKubeContext() {
+	r := release.NewConfig()
+	r.NameF = "redis"
+	r.NamespaceF = "test"
+	r.KubeContextF = "prod"
+
+	s.Require().NoError(r.Uniq().Validate())
+	s.Require().Equal(uniqname.UniqName("redis@test@prod"), r.Uniq())
+}
+
+func (s *ConfigTestSuite) TestSetHelmDriverDefault() {
+	r := release.NewConfig()
+	r.SetHelmDriverDefault(helper.HelmDriver{Name: helper.HelmDriverMemory})
+
+	s.Require().Equal(helper.HelmDriverMemory, r.HelmDriverF.Name)
+
+	r.SetHelmDriverDefault(helper.HelmDriver{Name: helper.HelmDriverConfigMap})
+
+	s.Require().Equal(helper.HelmDriverMemory, r.HelmDriverF.Name)
+	s.Require().IsType(&driver.Memory{}, r.Cfg().Releases.Driver)
+}
+
 func TestConfigTestSuite(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(ConfigTestSuite))
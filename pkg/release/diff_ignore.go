@@ -0,0 +1,188 @@
+package release
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrDiffIgnorePath is returned when path of diff ignore rule cannot be parsed.
+var ErrDiffIgnorePath = errors.New("failed to parse diff ignore path")
+
+// DiffConfig is a configuration of differ.
+type DiffConfig struct {
+	Ignore []DiffIgnoreRule `yaml:"ignore,omitempty"`
+}
+
+// DiffIgnoreRule strips field found by path from matching resources before diffing.
+// Kind and name are glob patterns, empty pattern matches everything.
+// Path is dot-separated list of keys, keys with dots need brackets and quotes: metadata.labels["helm.sh/chart"].
+// Each key may be a glob pattern (annotations.checksum/*, labels.*), `*` matches `/` too.
+// List items are matched with [0] or [*].
+type DiffIgnoreRule struct {
+	Kind string `yaml:"kind,omitempty"`
+	Name string `yaml:"name,omitempty"`
+	Path string `yaml:"path"`
+
+	segments []string
+}
+
+// UnmarshalYAML is used to implement Unmarshaler interface of gopkg.in/yaml.v3.
+func (r *DiffIgnoreRule) UnmarshalYAML(node *yaml.Node) error {
+	type raw DiffIgnoreRule
+	if err := node.Decode((*raw)(r)); err != nil {
+		return fmt.Errorf("failed to decode diff ignore rule from YAML: %w", err)
+	}
+
+	segments, err := parseDiffIgnorePath(r.Path)
+	if err != nil {
+		return err
+	}
+	r.segments = segments
+
+	return nil
+}
+
+// Match checks whether rule should be applied to resource.
+func (r *DiffIgnoreRule) Match(kind, name string) bool {
+	return matchGlob(r.Kind, kind) && matchGlob(r.Name, name)
+}
+
+// Strip removes fields found by rule path from YAML node. Returns true if anything has been removed.
+func (r *DiffIgnoreRule) Strip(node *yaml.Node) bool {
+	if r.segments == nil {
+		segments, err := parseDiffIgnorePath(r.Path)
+		if err != nil {
+			return false
+		}
+		r.segments = segments
+	}
+
+	if node.Kind == yaml.DocumentNode {
+		stripped := false
+		for _, n := range node.Content {
+			stripped = stripNode(n, r.segments) || stripped
+		}
+
+		return stripped
+	}
+
+	return stripNode(node, r.segments)
+}
+
+func stripNode(node *yaml.Node, segments []string) bool {
+	if len(segments) == 0 || node == nil {
+		return false
+	}
+
+	seg, last := segments[0], len(segments) == 1
+	stripped := false
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		content := make([]*yaml.Node, 0, len(node.Content))
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			k, v := node.Content[i], node.Content[i+1]
+
+			if matchGlob(seg, k.Value) {
+				if last {
+					stripped = true
+
+					continue
+				}
+				stripped = stripNode(v, segments[1:]) || stripped
+			}
+
+			content = append(content, k, v)
+		}
+		node.Content = content
+	case yaml.SequenceNode:
+		content := make([]*yaml.Node, 0, len(node.Content))
+		for i, v := range node.Content {
+			if seg == "*" || seg == strconv.Itoa(i) {
+				if last {
+					stripped = true
+
+					continue
+				}
+				stripped = stripNode(v, segments[1:]) || stripped
+			}
+
+			content = append(content, v)
+		}
+		node.Content = content
+	default:
+	}
+
+	return stripped
+}
+
+// matchGlob matches s with shell pattern. Unlike path.Match, `*` and `?` match `/` too,
+// so that keys like `helm.sh/chart` and `checksum/config` are matched by `*`.
+func matchGlob(pattern, s string) bool {
+	if pattern == "" || pattern == s {
+		return true
+	}
+
+	// path.Match treats only `/` as separator, so hide it from both sides
+	ok, err := path.Match(strings.ReplaceAll(pattern, "/", "\x00"), strings.ReplaceAll(s, "/", "\x00"))
+
+	return err == nil && ok
+}
+
+// parseDiffIgnorePath splits path like `a.b["c.d"][0].e/*` into segments `a`, `b`, `c.d`, `0`, `e/*`.
+func parseDiffIgnorePath(p string) ([]string, error) {
+	if p == "" {
+		return nil, fmt.Errorf("%w: path is empty", ErrDiffIgnorePath)
+	}
+
+	segments := make([]string, 0)
+	cur := strings.Builder{}
+
+	for i := 0; i < len(p); i++ {
+		switch p[i] {
+		case '.':
+			if cur.Len() > 0 {
+				segments = append(segments, cur.String())
+				cur.Reset()
+			}
+		case '[':
+			if cur.Len() > 0 {
+				segments = append(segments, cur.String())
+				cur.Reset()
+			}
+
+			end := strings.IndexByte(p[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("%w %q: unclosed bracket", ErrDiffIgnorePath, p)
+			}
+
+			key := p[i+1 : i+end]
+			if unquoted, err := strconv.Unquote(key); err == nil {
+				key = unquoted
+			}
+			if key == "" {
+				return nil, fmt.Errorf("%w %q: empty brackets", ErrDiffIgnorePath, p)
+			}
+
+			segments = append(segments, key)
+			i += end
+		default:
+			cur.WriteByte(p[i])
+		}
+	}
+
+	if cur.Len() > 0 {
+		segments = append(segments, cur.String())
+	}
+
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("%w %q: no keys found", ErrDiffIgnorePath, p)
+	}
+
+	return segments, nil
+}
@@ -0,0 +1,111 @@
+package release_test
+
+import (
+	"testing"
+
+	"github.com/helmwave/helmwave/pkg/release"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type DiffIgnoreTestSuite struct {
+	suite.Suite
+}
+
+const diffIgnoreResource = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+  labels:
+    app: nginx
+    helm.sh/chart: nginx-1.0.0
+spec:
+  template:
+    metadata:
+      annotations:
+        checksum/config: abc
+        checksum/secret: def
+        keep: me
+    spec:
+      containers:
+        - name: nginx
+          image: nginx:1.0
+`
+
+func (s *DiffIgnoreTestSuite) strip(src string) (bool, string) {
+	s.T().Helper()
+
+	node := &yaml.Node{}
+	s.Require().NoError(yaml.Unmarshal([]byte(diffIgnoreResource), node))
+
+	r := &release.DiffIgnoreRule{}
+	s.Require().NoError(yaml.Unmarshal([]byte(src), r))
+
+	stripped := r.Strip(node)
+
+	out, err := yaml.Marshal(node)
+	s.Require().NoError(err)
+
+	return stripped, string(out)
+}
+
+func (s *DiffIgnoreTestSuite) TestQuotedKey() {
+	stripped, out := s.strip(`path: metadata.labels["helm.sh/chart"]`)
+
+	s.Require().True(stripped)
+	s.Require().NotContains(out, "helm.sh/chart")
+	s.Require().Contains(out, "app: nginx")
+}
+
+func (s *DiffIgnoreTestSuite) TestGlobKey() {
+	stripped, out := s.strip(`path: spec.template.metadata.annotations.checksum/*`)
+
+	s.Require().True(stripped)
+	s.Require().NotContains(out, "checksum/")
+	s.Require().Contains(out, "keep: me")
+}
+
+func (s *DiffIgnoreTestSuite) TestGlobAllKeys() {
+	stripped, out := s.strip(`path: metadata.labels.*`)
+
+	s.Require().True(stripped)
+	s.Require().NotContains(out, "helm.sh/chart")
+	s.Require().NotContains(out, "app: nginx")
+	s.Require().Contains(out, "keep: me")
+}
+
+func (s *DiffIgnoreTestSuite) TestListIndex() {
+	stripped, out := s.strip(`path: spec.template.spec.containers[*].image`)
+
+	s.Require().True(stripped)
+	s.Require().NotContains(out, "nginx:1.0")
+}
+
+func (s *DiffIgnoreTestSuite) TestNotFound() {
+	stripped, out := s.strip(`path: spec.replicas`)
+
+	s.Require().False(stripped)
+	s.Require().Contains(out, "helm.sh/chart")
+}
+
+func (s *DiffIgnoreTestSuite) TestMatch() {
+	r := release.DiffIgnoreRule{Kind: "Deployment", Name: "nginx-*"}
+
+	s.Require().True(r.Match("Deployment", "nginx-a"))
+	s.Require().False(r.Match("Deployment", "redis"))
+	s.Require().False(r.Match("StatefulSet", "nginx-a"))
+	s.Require().True((&release.DiffIgnoreRule{}).Match("Service", "any"))
+	s.Require().True((&release.DiffIgnoreRule{Name: "*"}).Match("Service", "a/b"))
+}
+
+func (s *DiffIgnoreTestSuite) TestBadPath() {
+	for _, src := range []string{`path: ""`, `path: 'a["b"'`, `path: a[]`, `path: "..."`} {
+		r := &release.DiffIgnoreRule{}
+		s.Require().ErrorIs(yaml.Unmarshal([]byte(src), r), release.ErrDiffIgnorePath, src)
+	}
+}
+
+func TestDiffIgnoreTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(DiffIgnoreTestSuite))
+}
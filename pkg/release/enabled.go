@@ -0,0 +1,50 @@
+package release
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/helmwave/helmwave/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+// Enabled is a release `enabled` field. It is either boolean or template expression rendered to boolean.
+// Empty value means release is enabled.
+type Enabled string
+
+// UnmarshalYAML accepts any scalar, so both `enabled: false` and `enabled: '{{ ... }}'` are supported.
+func (e *Enabled) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return fmt.Errorf("failed to decode enabled: expected scalar, got %q", node.ShortTag())
+	}
+
+	*e = Enabled(node.Value)
+
+	return nil
+}
+
+// Enabled renders `enabled` expression of release with templater and parses it as boolean.
+func (rel *config) Enabled(templater string) (bool, error) {
+	if strings.TrimSpace(string(rel.EnabledF)) == "" {
+		return true, nil
+	}
+
+	data := struct {
+		Release Config
+	}{
+		Release: rel,
+	}
+
+	s, err := template.Render(string(rel.EnabledF), data, templater)
+	if err != nil {
+		return false, fmt.Errorf("failed to render enabled expression of release %s: %w", rel.Uniq(), err)
+	}
+
+	b, err := strconv.ParseBool(strings.TrimSpace(s))
+	if err != nil {
+		return false, fmt.Errorf("failed to parse enabled expression of release %s: %w", rel.Uniq(), err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,69 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type EnabledTestSuite struct {
+	suite.Suite
+}
+
+func (s *EnabledTestSuite) TestDefault() {
+	rel := NewConfig()
+
+	enabled, err := rel.Enabled("sprig")
+	s.Require().NoError(err)
+	s.Require().True(enabled)
+}
+
+func (s *EnabledTestSuite) TestUnmarshal() {
+	src := `
+- name: redis
+  namespace: test
+  enabled: false
+- name: memcached
+  namespace: test
+  enabled: '{{ has "cache" .Release.Tags }}'
+  tags: [cache]
+`
+	var r Configs
+	s.Require().NoError(yaml.Unmarshal([]byte(src), &r))
+	s.Require().Len(r, 2)
+
+	enabled, err := r[0].Enabled("sprig")
+	s.Require().NoError(err)
+	s.Require().False(enabled)
+
+	enabled, err = r[1].Enabled("sprig")
+	s.Require().NoError(err)
+	s.Require().True(enabled)
+}
+
+//nolint:paralleltest // uses t.Setenv
+func (s *EnabledTestSuite) TestEnv() {
+	s.T().Setenv("HELMWAVE_TEST_ENABLED", "false")
+
+	rel := NewConfig()
+	rel.EnabledF = `{{ env "HELMWAVE_TEST_ENABLED" }}`
+
+	enabled, err := rel.Enabled("sprig")
+	s.Require().NoError(err)
+	s.Require().False(enabled)
+}
+
+func (s *EnabledTestSuite) TestNotBool() {
+	rel := NewConfig()
+	rel.EnabledF = "maybe"
+
+	_, err := rel.Enabled("sprig")
+	s.Require().Error(err)
+}
+
+//nolint:paralleltest // uses t.Setenv
+func TestEnabledTestSuite(t *testing.T) {
+	// t.Parallel()
+	suite.Run(t, new(EnabledTestSuite))
+}
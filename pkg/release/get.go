@@ -1,18 +1,25 @@
 package release
 
 import (
+	"errors"
 	"fmt"
 
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
 )
 
-func (rel *config) Get() (*release.Release, error) {
+// ErrRevisionNotFound is returned when requested revision is out of release history.
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// Get returns release of provided revision.
+// Positive version is an exact revision, negative version is relative to the latest revision (-1 is the previous one).
+// Zero means the last deployed revision or the latest one if release has never been deployed successfully.
+func (rel *config) Get(version int) (*release.Release, error) {
 	// IDK wtf is going on
 	rel.cfg = nil
-	client := action.NewGet(rel.Cfg())
 
-	r, err := client.Run(rel.Name())
+	r, err := rel.get(version)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get release %s: %w", rel.Uniq(), err)
 	}
@@ -20,6 +27,48 @@ func (rel *config) Get() (*release.Release, error) {
 	return r, nil
 }
 
+func (rel *config) get(version int) (*release.Release, error) {
+	client := action.NewGet(rel.Cfg())
+
+	switch {
+	case version > 0:
+		client.Version = version
+	case version < 0:
+		last, err := rel.Cfg().Releases.Last(rel.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the latest revision: %w", err)
+		}
+
+		client.Version = last.Version + version
+		if client.Version < 1 {
+			return nil, fmt.Errorf("%w: %d revisions before the latest %d", ErrRevisionNotFound, -version, last.Version)
+		}
+	default:
+		r, err := rel.Cfg().Releases.Deployed(rel.Name())
+		if err == nil {
+			return r, nil
+		}
+
+		if !errors.Is(err, driver.ErrNoDeployedReleases) && !errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, fmt.Errorf("failed to get the last deployed revision: %w", err)
+		}
+	}
+
+	if client.Version > 0 {
+		rel.Logger().Infof("using %d revision", client.Version)
+	}
+
+	r, err := client.Run(rel.Name())
+	if errors.Is(err, driver.ErrReleaseNotFound) && client.Version > 0 {
+		// Storage doesn't tell missing revision from missing release
+		if last, lastErr := rel.Cfg().Releases.Last(rel.Name()); lastErr == nil {
+			return nil, fmt.Errorf("%w: %d, the latest is %d", ErrRevisionNotFound, client.Version, last.Version)
+		}
+	}
+
+	return r, err
+}
+
 func (rel *config) GetValues() (map[string]interface{}, error) {
 	client := action.NewGetValues(rel.Cfg())
 
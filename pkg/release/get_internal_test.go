@@ -0,0 +1,97 @@
+package release
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+type GetInternalTestSuite struct {
+	suite.Suite
+}
+
+func (s *GetInternalTestSuite) newConfig(statuses ...release.Status) *config {
+	s.T().Helper()
+
+	rel := NewConfig()
+	rel.cfg = &action.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(string, ...interface{}) {},
+	}
+
+	for i, st := range statuses {
+		r := &release.Release{
+			Name:      rel.Name(),
+			Namespace: rel.Namespace(),
+			Version:   i + 1,
+			Info:      &release.Info{Status: st},
+		}
+		s.Require().NoError(rel.cfg.Releases.Create(r))
+	}
+
+	return rel
+}
+
+func (s *GetInternalTestSuite) TestLastDeployed() {
+	rel := s.newConfig(release.StatusSuperseded, release.StatusDeployed, release.StatusFailed)
+
+	r, err := rel.get(0)
+	s.Require().NoError(err)
+	s.Require().Equal(2, r.Version)
+}
+
+func (s *GetInternalTestSuite) TestNeverDeployed() {
+	rel := s.newConfig(release.StatusFailed, release.StatusFailed)
+
+	r, err := rel.get(0)
+	s.Require().NoError(err)
+	s.Require().Equal(2, r.Version)
+}
+
+func (s *GetInternalTestSuite) TestExactRevision() {
+	rel := s.newConfig(release.StatusSuperseded, release.StatusSuperseded, release.StatusDeployed)
+
+	r, err := rel.get(1)
+	s.Require().NoError(err)
+	s.Require().Equal(1, r.Version)
+}
+
+func (s *GetInternalTestSuite) TestRelativeRevision() {
+	rel := s.newConfig(release.StatusSuperseded, release.StatusSuperseded, release.StatusDeployed)
+
+	r, err := rel.get(-1)
+	s.Require().NoError(err)
+	s.Require().Equal(2, r.Version)
+
+	_, err = rel.get(-3)
+	s.Require().ErrorIs(err, ErrRevisionNotFound)
+}
+
+func (s *GetInternalTestSuite) TestExactRevisionNotFound() {
+	rel := s.newConfig(release.StatusDeployed)
+
+	_, err := rel.get(5)
+	s.Require().ErrorIs(err, ErrRevisionNotFound)
+	s.Require().NotErrorIs(err, driver.ErrReleaseNotFound)
+}
+
+func (s *GetInternalTestSuite) TestNotFound() {
+	rel := s.newConfig()
+
+	_, err := rel.get(0)
+	s.Require().ErrorIs(err, driver.ErrReleaseNotFound)
+}
+
+func TestGetInternalTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(GetInternalTestSuite))
+}
@@ -0,0 +1,31 @@
+package release
+
+import (
+	"fmt"
+	"sort"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// History returns up to limit latest revisions of release sorted by version.
+// Non-positive limit means all revisions.
+func (rel *config) History(limit int) ([]*release.Release, error) {
+	// helm applies Max only in its CLI, so we have to limit history ourselves
+	client := action.NewHistory(rel.Cfg())
+
+	r, err := client.Run(rel.Name())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of release %s: %w", rel.Uniq(), err)
+	}
+
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].Version < r[j].Version
+	})
+
+	if limit > 0 && len(r) > limit {
+		r = r[len(r)-limit:]
+	}
+
+	return r, nil
+}
@@ -0,0 +1,65 @@
+package release
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+type HistoryTestSuite struct {
+	suite.Suite
+}
+
+func (s *HistoryTestSuite) newConfig(revisions int) *config {
+	s.T().Helper()
+
+	rel := NewConfig()
+	rel.cfg = &action.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(string, ...interface{}) {},
+	}
+
+	for i := 1; i <= revisions; i++ {
+		r := &release.Release{
+			Name:      rel.Name(),
+			Namespace: rel.Namespace(),
+			Version:   i,
+			Info:      &release.Info{Status: release.StatusSuperseded},
+		}
+		s.Require().NoError(rel.cfg.Releases.Create(r))
+	}
+
+	return rel
+}
+
+func (s *HistoryTestSuite) TestLimit() {
+	rel := s.newConfig(5)
+
+	r, err := rel.History(3)
+	s.Require().NoError(err)
+	s.Require().Len(r, 3)
+	s.Require().Equal(3, r[0].Version)
+	s.Require().Equal(5, r[2].Version)
+}
+
+func (s *HistoryTestSuite) TestNoLimit() {
+	rel := s.newConfig(5)
+
+	r, err := rel.History(0)
+	s.Require().NoError(err)
+	s.Require().Len(r, 5)
+}
+
+func TestHistoryTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(HistoryTestSuite))
+}
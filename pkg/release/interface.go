@@ -2,10 +2,15 @@ package release
 
 import (
 	"fmt"
+	"io"
+	"time"
 
+	"github.com/helmwave/helmwave/pkg/helper"
+	"github.com/helmwave/helmwave/pkg/hooks"
 	"github.com/helmwave/helmwave/pkg/release/uniqname"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/release"
 )
 
@@ -14,24 +19,43 @@ type Config interface {
 	Uniq() uniqname.UniqName
 	HandleDependencies([]Config)
 	Sync() (*release.Release, error)
+	SetRetryDefaults(RetryOptions)
+	SetHelmDriverDefault(helper.HelmDriver)
+	Attempts() int
 	NotifySuccess()
 	NotifyFailed()
 	DryRun(bool)
 	ChartDepsUpd() error
 	In([]Config) bool
-	BuildValues(string, string) error
+	BuildValues(string, string, *TemplatePlan) error
 	Uninstall() (*release.UninstallReleaseResponse, error)
-	Get() (*release.Release, error)
+	UninstallOptions() UninstallOptions
+	DeleteNamespace() error
+	Get(int) (*release.Release, error)
+	GetValues() (map[string]interface{}, error)
+	MergedValues() (map[string]interface{}, error)
+	GetChart() (*chart.Chart, error)
+	VendorChart(string) error
 	List() (*release.Release, error)
 	Rollback(int) error
 	Status() (*release.Release, error)
+	History(int) ([]*release.Release, error)
+	Test(time.Duration, io.Writer) (*release.Release, error)
 	Name() string
 	Namespace() string
+	KubeContext() string
+	KubeConfig() string
 	Chart() Chart
+	LockChart(string, string)
 	DependsOn() []string
+	Enabled(string) (bool, error)
 	Tags() []string
+	Store() map[string]interface{}
+	MergeStore(map[string]interface{})
 	Repo() string
 	Values() []ValuesReference
+	DiffIgnore() []DiffIgnoreRule
+	Lifecycle() hooks.Lifecycle
 	Logger() *log.Entry
 }
 
@@ -0,0 +1,160 @@
+package release
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"helm.sh/helm/v3/pkg/postrender"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// PostRendererConfig is a configuration of helm post-renderer.
+// Exec is an executable that gets rendered manifests via stdin and returns modified ones via stdout.
+// Patches are built-in JSON merge patches applied after exec.
+type PostRendererConfig struct {
+	Exec    string              `yaml:"exec,omitempty"`
+	Args    []string            `yaml:"args,omitempty"`
+	Patches []PostRendererPatch `yaml:"patches,omitempty"`
+}
+
+// PostRendererPatch is a JSON merge patch (RFC 7386) applied to matching resources.
+// Kind and name are glob patterns, empty pattern matches everything.
+type PostRendererPatch struct {
+	Kind  string                 `yaml:"kind,omitempty"`
+	Name  string                 `yaml:"name,omitempty"`
+	Patch map[string]interface{} `yaml:"patch"`
+}
+
+// postRenderer runs exec post-renderer and built-in patches in order.
+type postRenderer struct {
+	exec    postrender.PostRenderer
+	patches []PostRendererPatch
+}
+
+// PostRenderer returns helm post-renderer for release or nil if it is not configured.
+func (rel *config) PostRenderer() (postrender.PostRenderer, error) {
+	c := rel.PostRendererF
+	if c.Exec == "" && len(c.Patches) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	pr := &postRenderer{patches: c.Patches}
+
+	if c.Exec != "" {
+		e, err := postrender.NewExec(c.Exec, c.Args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create post-renderer %s: %w", c.Exec, err)
+		}
+		pr.exec = e
+	}
+
+	return pr, nil
+}
+
+// Run is used to implement PostRenderer interface of helm.
+func (pr *postRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	var err error
+
+	if pr.exec != nil {
+		renderedManifests, err = pr.exec.Run(renderedManifests)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run post-renderer: %w", err)
+		}
+	}
+
+	if len(pr.patches) == 0 {
+		return renderedManifests, nil
+	}
+
+	docs := releaseutil.SplitManifests(renderedManifests.String())
+	keys := make([]string, 0, len(docs))
+	for k := range docs {
+		keys = append(keys, k)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+	res := &bytes.Buffer{}
+	for _, k := range keys {
+		doc, err := pr.patch(docs[k])
+		if err != nil {
+			return nil, err
+		}
+
+		res.WriteString("---\n")
+		res.WriteString(doc)
+		res.WriteString("\n")
+	}
+
+	return res, nil
+}
+
+// patch applies all matching patches to a single YAML document. Leading comments are kept.
+func (pr *postRenderer) patch(doc string) (string, error) {
+	meta := struct {
+		Kind     string `json:"kind"`
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}{}
+
+	if err := k8syaml.Unmarshal([]byte(doc), &meta); err != nil {
+		return "", fmt.Errorf("failed to decode rendered manifest: %w", err)
+	}
+
+	if meta.Kind == "" {
+		return doc, nil
+	}
+
+	var data []byte
+	for i := range pr.patches {
+		p := pr.patches[i]
+		if !matchGlob(p.Kind, meta.Kind) || !matchGlob(p.Name, meta.Metadata.Name) {
+			continue
+		}
+
+		if data == nil {
+			var err error
+			data, err = k8syaml.YAMLToJSON([]byte(doc))
+			if err != nil {
+				return "", fmt.Errorf("failed to decode %s %s: %w", meta.Kind, meta.Metadata.Name, err)
+			}
+		}
+
+		patch, err := k8syaml.Marshal(p.Patch)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode patch for %s %s: %w", meta.Kind, meta.Metadata.Name, err)
+		}
+		patch, err = k8syaml.YAMLToJSON(patch)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode patch for %s %s: %w", meta.Kind, meta.Metadata.Name, err)
+		}
+
+		data, err = jsonpatch.MergePatch(data, patch)
+		if err != nil {
+			return "", fmt.Errorf("failed to patch %s %s: %w", meta.Kind, meta.Metadata.Name, err)
+		}
+	}
+
+	if data == nil {
+		return doc, nil
+	}
+
+	out, err := k8syaml.JSONToYAML(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode %s %s: %w", meta.Kind, meta.Metadata.Name, err)
+	}
+
+	comments := make([]string, 0, 1)
+	for _, line := range strings.Split(doc, "\n") {
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		comments = append(comments, line)
+	}
+
+	return strings.Join(append(comments, strings.TrimSpace(string(out))), "\n"), nil
+}
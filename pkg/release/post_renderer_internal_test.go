@@ -0,0 +1,89 @@
+package release
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PostRendererTestSuite struct {
+	suite.Suite
+}
+
+const postRendererManifest = `---
+# Source: test/templates/cm.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+data:
+  a: b
+---
+# Source: test/templates/sa.yaml
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: sa
+`
+
+func (s *PostRendererTestSuite) TestNotConfigured() {
+	rel := NewConfig()
+
+	pr, err := rel.PostRenderer()
+	s.Require().NoError(err)
+	s.Require().Nil(pr)
+}
+
+func (s *PostRendererTestSuite) TestPatches() {
+	rel := NewConfig()
+	rel.PostRendererF.Patches = []PostRendererPatch{
+		{
+			Kind: "ConfigMap",
+			Patch: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"team": "infra"},
+				},
+				"data": map[string]interface{}{"a": nil, "c": "d"},
+			},
+		},
+	}
+
+	pr, err := rel.PostRenderer()
+	s.Require().NoError(err)
+	s.Require().NotNil(pr)
+
+	out, err := pr.Run(bytes.NewBufferString(postRendererManifest))
+	s.Require().NoError(err)
+
+	s.Require().Contains(out.String(), "# Source: test/templates/cm.yaml\n")
+	s.Require().Contains(out.String(), "team: infra")
+	s.Require().Contains(out.String(), "c: d")
+	s.Require().NotContains(out.String(), "a: b")
+	s.Require().Contains(out.String(), "# Source: test/templates/sa.yaml\napiVersion: v1\nkind: ServiceAccount\nmetadata:\n  name: sa\n")
+}
+
+func (s *PostRendererTestSuite) TestExec() {
+	rel := NewConfig()
+	rel.PostRendererF.Exec = "cat"
+
+	pr, err := rel.PostRenderer()
+	s.Require().NoError(err)
+
+	out, err := pr.Run(bytes.NewBufferString(postRendererManifest))
+	s.Require().NoError(err)
+	s.Require().Equal(postRendererManifest, out.String())
+}
+
+func (s *PostRendererTestSuite) TestExecNotFound() {
+	rel := NewConfig()
+	rel.PostRendererF.Exec = "./not-existing-post-renderer"
+
+	_, err := rel.PostRenderer()
+	s.Require().Error(err)
+}
+
+func TestPostRendererTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(PostRendererTestSuite))
+}
@@ -0,0 +1,100 @@
+package release
+
+import (
+	"errors"
+	"fmt"
+
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+const (
+	// RecoverPendingFail fails deploy of release that is stuck in pending state. It is the default.
+	RecoverPendingFail = "fail"
+
+	// RecoverPendingRollback rolls back release that is stuck in pending state to the last deployed revision.
+	// Release is uninstalled if it has never been deployed.
+	RecoverPendingRollback = "rollback"
+
+	// RecoverPendingUninstall uninstalls release that is stuck in pending state if it has never been deployed.
+	// Releases with deployed revisions are not uninstalled to keep live workloads.
+	RecoverPendingUninstall = "uninstall"
+)
+
+var (
+	// ErrPendingRelease is returned when release is stuck in pending state and recovery is disabled.
+	ErrPendingRelease = errors.New("release is stuck in pending state, another operation may be in progress")
+
+	// ErrPendingDeployedRelease is returned when uninstall strategy is used for pending release with deployed revisions.
+	ErrPendingDeployedRelease = errors.New("pending release has deployed revisions, refusing to uninstall it")
+
+	// ErrUnknownRecoverPending is returned for unsupported recover_pending value.
+	ErrUnknownRecoverPending = errors.New("unknown recover_pending strategy")
+)
+
+// recoverPending checks status of release and recovers it from pending state with recover_pending strategy.
+func (rel *config) recoverPending() error {
+	r, err := rel.Status()
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if r.Info == nil || !r.Info.Status.IsPending() {
+		return nil
+	}
+
+	l := rel.Logger().WithField("status", r.Info.Status)
+
+	switch rel.RecoverPending {
+	case RecoverPendingFail, "":
+		return fmt.Errorf("%w: %s is %s", ErrPendingRelease, rel.Uniq(), r.Info.Status)
+	case RecoverPendingRollback:
+		deployed, err := rel.Cfg().Releases.Deployed(rel.Name())
+		if errors.Is(err, driver.ErrNoDeployedReleases) {
+			l.Warn("🚑 release has never been deployed, nothing to roll back to")
+
+			return rel.uninstallPending()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get the last deployed revision of %s: %w", rel.Uniq(), err)
+		}
+
+		l.Warnf("🚑 release is stuck, rolling it back to %d revision", deployed.Version)
+
+		return rel.Rollback(deployed.Version)
+	case RecoverPendingUninstall:
+		if r.Info.Status != release.StatusPendingInstall {
+			_, err := rel.Cfg().Releases.Deployed(rel.Name())
+			if err == nil {
+				return fmt.Errorf("%w: %s is %s", ErrPendingDeployedRelease, rel.Uniq(), r.Info.Status)
+			}
+			if !errors.Is(err, driver.ErrNoDeployedReleases) {
+				return fmt.Errorf("failed to get the last deployed revision of %s: %w", rel.Uniq(), err)
+			}
+		}
+
+		l.Warn("🚑 release is stuck")
+
+		return rel.uninstallPending()
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownRecoverPending, rel.RecoverPending)
+	}
+}
+
+// uninstallPending cleans up release in pending state. Lifecycle hooks are not run.
+// History is never kept, otherwise uninstalled record blocks the following install.
+func (rel *config) uninstallPending() error {
+	rel.Logger().Warn("🚑 uninstalling pending release")
+
+	client := rel.newUninstall()
+	client.KeepHistory = false
+
+	if _, err := client.Run(rel.Name()); err != nil {
+		return fmt.Errorf("failed to uninstall pending release %s: %w", rel.Uniq(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package release
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+type RecoverTestSuite struct {
+	suite.Suite
+}
+
+func (s *RecoverTestSuite) newConfig(strategy string, statuses ...release.Status) *config {
+	s.T().Helper()
+
+	rel := NewConfig()
+	rel.RecoverPending = strategy
+	rel.cfg = &action.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(string, ...interface{}) {},
+	}
+
+	for i, st := range statuses {
+		r := &release.Release{
+			Name:      rel.Name(),
+			Namespace: rel.Namespace(),
+			Version:   i + 1,
+			Info:      &release.Info{Status: st},
+			Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: "blabla", Version: "1.0.0"}},
+		}
+		s.Require().NoError(rel.cfg.Releases.Create(r))
+	}
+
+	return rel
+}
+
+func (s *RecoverTestSuite) TestNotInstalled() {
+	rel := s.newConfig(RecoverPendingFail)
+
+	s.Require().NoError(rel.recoverPending())
+}
+
+func (s *RecoverTestSuite) TestNotPending() {
+	rel := s.newConfig(RecoverPendingFail, release.StatusDeployed)
+
+	s.Require().NoError(rel.recoverPending())
+}
+
+func (s *RecoverTestSuite) TestFail() {
+	rel := s.newConfig("", release.StatusDeployed, release.StatusPendingUpgrade)
+
+	s.Require().ErrorIs(rel.recoverPending(), ErrPendingRelease)
+}
+
+func (s *RecoverTestSuite) TestUnknown() {
+	rel := s.newConfig("blabla", release.StatusPendingInstall)
+
+	s.Require().ErrorIs(rel.recoverPending(), ErrUnknownRecoverPending)
+}
+
+func (s *RecoverTestSuite) TestRollback() {
+	rel := s.newConfig(RecoverPendingRollback, release.StatusDeployed, release.StatusPendingUpgrade)
+
+	s.Require().NoError(rel.recoverPending())
+
+	last, err := rel.cfg.Releases.Last(rel.Name())
+	s.Require().NoError(err)
+	s.Require().Equal(3, last.Version)
+	s.Require().Equal(release.StatusDeployed, last.Info.Status)
+}
+
+func (s *RecoverTestSuite) TestRollbackNeverDeployed() {
+	rel := s.newConfig(RecoverPendingRollback, release.StatusPendingInstall)
+
+	s.Require().NoError(rel.recoverPending())
+
+	_, err := rel.cfg.Releases.Last(rel.Name())
+	s.Require().ErrorIs(err, driver.ErrReleaseNotFound)
+}
+
+func (s *RecoverTestSuite) TestUninstall() {
+	rel := s.newConfig(RecoverPendingUninstall, release.StatusPendingInstall)
+
+	s.Require().NoError(rel.recoverPending())
+
+	_, err := rel.cfg.Releases.Last(rel.Name())
+	s.Require().ErrorIs(err, driver.ErrReleaseNotFound)
+}
+
+func (s *RecoverTestSuite) TestUninstallKeepHistory() {
+	rel := s.newConfig(RecoverPendingUninstall, release.StatusPendingInstall)
+	rel.UninstallF.KeepHistory = true
+
+	s.Require().NoError(rel.recoverPending())
+
+	_, err := rel.cfg.Releases.Last(rel.Name())
+	s.Require().ErrorIs(err, driver.ErrReleaseNotFound)
+}
+
+func (s *RecoverTestSuite) TestUninstallDeployed() {
+	rel := s.newConfig(RecoverPendingUninstall, release.StatusDeployed, release.StatusPendingUpgrade)
+
+	s.Require().ErrorIs(rel.recoverPending(), ErrPendingDeployedRelease)
+
+	last, err := rel.cfg.Releases.Last(rel.Name())
+	s.Require().NoError(err)
+	s.Require().Equal(2, last.Version)
+	s.Require().Equal(release.StatusPendingUpgrade, last.Info.Status)
+}
+
+func TestRecoverTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(RecoverTestSuite))
+}
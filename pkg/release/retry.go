@@ -0,0 +1,105 @@
+package release
+
+import (
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"helm.sh/helm/v3/pkg/release"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// RetryOptions configures retries of release sync. Project options are used for releases that don't set them.
+type RetryOptions struct {
+	Retries      int           `yaml:"retries,omitempty"`
+	RetryBackoff time.Duration `yaml:"retry_backoff,omitempty"`
+}
+
+// retryableMessages are parts of error messages that mean transient failure.
+// Helm often flattens errors to strings, so error types can't be checked.
+var retryableMessages = []string{
+	"connection reset by peer",
+	"connection refused",
+	"i/o timeout",
+	"TLS handshake timeout",
+	"context deadline exceeded",
+	"failed calling webhook",
+	"the object has been modified",
+	"etcdserver: request timed out",
+	"the server is currently unable to handle the request",
+	"Internal error occurred",
+	"unexpected EOF",
+}
+
+// SetRetryDefaults sets retry options that are not set for release.
+func (rel *config) SetRetryDefaults(o RetryOptions) {
+	if rel.Retries == 0 {
+		rel.Retries = o.Retries
+	}
+
+	if rel.RetryBackoff == 0 {
+		rel.RetryBackoff = o.RetryBackoff
+	}
+}
+
+// Attempts returns number of attempts of the last sync.
+func (rel *config) Attempts() int {
+	return rel.attempts
+}
+
+// withRetries runs fn until it succeeds, fails with error that is not retryable or retries are exhausted.
+// Backoff is doubled after every failed attempt.
+func (rel *config) withRetries(fn func() (*release.Release, error)) (*release.Release, error) {
+	backoff := rel.RetryBackoff
+
+	for rel.attempts = 1; ; rel.attempts++ {
+		if rel.Retries > 0 {
+			rel.Logger().Infof("🔁 attempt %d/%d", rel.attempts, rel.Retries+1)
+		}
+
+		r, err := fn()
+		if err == nil {
+			return r, nil
+		}
+
+		if rel.attempts > rel.Retries || !isRetryable(err) {
+			return nil, err
+		}
+
+		rel.Logger().WithError(err).Warnf("🔁 attempt %d/%d failed, retrying in %s", rel.attempts, rel.Retries+1, backoff)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// isRetryable returns true for transient errors: connection problems, 5xx responses and conflicts.
+func isRetryable(err error) bool {
+	var status k8serrors.APIStatus
+	if errors.As(err, &status) {
+		code := status.Status().Code
+		if code >= 500 || k8serrors.IsConflict(err) || k8serrors.IsTooManyRequests(err) || k8serrors.IsServerTimeout(err) {
+			return true
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	msg := err.Error()
+	for _, m := range retryableMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+
+	return false
+}
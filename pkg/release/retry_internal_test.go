@@ -0,0 +1,106 @@
+package release
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/release"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type RetryTestSuite struct {
+	suite.Suite
+}
+
+func (s *RetryTestSuite) TestIsRetryable() {
+	gr := schema.GroupResource{Resource: "deployments"}
+
+	retryable := []error{
+		k8serrors.NewConflict(gr, "blabla", errors.New("the object has been modified")),
+		k8serrors.NewInternalError(errors.New("blabla")),
+		k8serrors.NewServiceUnavailable("blabla"),
+		k8serrors.NewTooManyRequests("blabla", 1),
+		fmt.Errorf("failed to upgrade: %w", syscall.ECONNRESET),
+		errors.New(`Internal error occurred: failed calling webhook "blabla": context deadline exceeded`),
+	}
+	for _, err := range retryable {
+		s.Require().True(isRetryable(err), err.Error())
+	}
+
+	notRetryable := []error{
+		k8serrors.NewNotFound(gr, "blabla"),
+		k8serrors.NewBadRequest("blabla"),
+		errors.New(`template: redis/templates/deployment.yaml:1:3: executing "blabla": nil pointer evaluating`),
+	}
+	for _, err := range notRetryable {
+		s.Require().False(isRetryable(err), err.Error())
+	}
+}
+
+func (s *RetryTestSuite) TestRetries() {
+	rel := NewConfig()
+	rel.Retries = 2
+	rel.RetryBackoff = time.Millisecond
+
+	calls := 0
+	_, err := rel.withRetries(func() (*release.Release, error) {
+		calls++
+		if calls < 3 {
+			return nil, syscall.ECONNRESET
+		}
+
+		return &release.Release{}, nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(3, calls)
+	s.Require().Equal(3, rel.Attempts())
+}
+
+func (s *RetryTestSuite) TestRetriesExhausted() {
+	rel := NewConfig()
+	rel.Retries = 1
+
+	calls := 0
+	_, err := rel.withRetries(func() (*release.Release, error) {
+		calls++
+
+		return nil, syscall.ECONNRESET
+	})
+	s.Require().ErrorIs(err, syscall.ECONNRESET)
+	s.Require().Equal(2, calls)
+	s.Require().Equal(2, rel.Attempts())
+}
+
+func (s *RetryTestSuite) TestNotRetryable() {
+	rel := NewConfig()
+	rel.Retries = 5
+
+	calls := 0
+	e := errors.New(s.T().Name())
+	_, err := rel.withRetries(func() (*release.Release, error) {
+		calls++
+
+		return nil, e
+	})
+	s.Require().ErrorIs(err, e)
+	s.Require().Equal(1, calls)
+}
+
+func (s *RetryTestSuite) TestSetRetryDefaults() {
+	rel := NewConfig()
+	rel.Retries = 1
+	rel.SetRetryDefaults(RetryOptions{Retries: 3, RetryBackoff: time.Second})
+
+	s.Require().Equal(1, rel.Retries)
+	s.Require().Equal(time.Second, rel.RetryBackoff)
+}
+
+func TestRetryTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(RetryTestSuite))
+}
@@ -0,0 +1,36 @@
+package release
+
+// MergeStore deep-merges project store into release store. Values of release store win.
+func (rel *config) MergeStore(store map[string]interface{}) {
+	if len(store) == 0 {
+		return
+	}
+
+	rel.StoreF = mergeStore(store, rel.StoreF)
+}
+
+// mergeStore returns new map with dst merged over src. Nested maps are merged recursively, other values of dst win.
+func mergeStore(src, dst map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(src)+len(dst))
+
+	for k, v := range src {
+		if m, ok := v.(map[string]interface{}); ok {
+			v = mergeStore(m, nil)
+		}
+		res[k] = v
+	}
+
+	for k, v := range dst {
+		dm, dstIsMap := v.(map[string]interface{})
+		sm, srcIsMap := res[k].(map[string]interface{})
+		if dstIsMap && srcIsMap {
+			res[k] = mergeStore(sm, dm)
+
+			continue
+		}
+
+		res[k] = v
+	}
+
+	return res
+}
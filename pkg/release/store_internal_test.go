@@ -0,0 +1,53 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+}
+
+func (s *StoreTestSuite) TestMergeStore() {
+	project := map[string]interface{}{
+		"domain": "example.com",
+		"region": "eu",
+		"registry": map[string]interface{}{
+			"host": "registry.example.com",
+			"user": "ci",
+		},
+	}
+
+	a := NewConfig()
+	a.StoreF = map[string]interface{}{
+		"region": "us",
+		"registry": map[string]interface{}{
+			"user": "a",
+		},
+	}
+	a.MergeStore(project)
+
+	b := NewConfig()
+	b.MergeStore(project)
+
+	s.Require().Equal(map[string]interface{}{
+		"domain": "example.com",
+		"region": "us",
+		"registry": map[string]interface{}{
+			"host": "registry.example.com",
+			"user": "a",
+		},
+	}, a.Store())
+	s.Require().Equal(project, b.Store())
+
+	// project store must not be shared with releases
+	b.Store()["registry"].(map[string]interface{})["user"] = "b"
+	s.Require().Equal("ci", project["registry"].(map[string]interface{})["user"])
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(StoreTestSuite))
+}
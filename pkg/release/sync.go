@@ -13,13 +13,35 @@ func (rel *config) Sync() (*release.Release, error) {
 		return nil, err
 	}
 
-	return rel.upgrade()
+	// Lifecycle hooks don't run for dry run, i.e. during build
+	if rel.dryRun {
+		return rel.withRetries(rel.upgrade)
+	}
+
+	if err := rel.recoverPending(); err != nil {
+		return nil, err
+	}
+
+	if err := rel.Lifecycle().PreUp.Run(rel.Logger(), "pre_up"); err != nil {
+		return nil, err
+	}
+
+	r, err := rel.withRetries(rel.upgrade)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rel.Lifecycle().PostUp.Run(rel.Logger(), "post_up"); err != nil {
+		return r, err
+	}
+
+	return r, nil
 }
 
 func (rel *config) Cfg() *action.Configuration {
 	if rel.cfg == nil {
 		var err error
-		rel.cfg, err = helper.NewCfg(rel.Namespace())
+		rel.cfg, err = helper.NewCfg(rel.Namespace(), rel.KubeContext(), rel.KubeConfig(), rel.HelmDriverF)
 		if err != nil {
 			rel.Logger().Fatal(err)
 
@@ -33,7 +55,7 @@ func (rel *config) Cfg() *action.Configuration {
 func (rel *config) Helm() *helm.EnvSettings {
 	if rel.helm == nil {
 		var err error
-		rel.helm, err = helper.NewHelm(rel.Namespace())
+		rel.helm, err = helper.NewHelm(rel.Namespace(), rel.KubeContext(), rel.KubeConfig())
 		if err != nil {
 			rel.Logger().Fatal(err)
 
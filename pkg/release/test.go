@@ -0,0 +1,31 @@
+package release
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+// Test runs helm tests of release. Logs of test pods are written to logs if it is not nil.
+func (rel *config) Test(timeout time.Duration, logs io.Writer) (*release.Release, error) {
+	client := action.NewReleaseTesting(rel.Cfg())
+	client.Namespace = rel.Namespace()
+	client.Timeout = timeout
+
+	r, err := client.Run(rel.Name())
+
+	if r != nil && logs != nil {
+		if err := client.GetPodLogs(logs, r); err != nil {
+			rel.Logger().WithError(err).Warn("failed to get logs of test pods")
+		}
+	}
+
+	if err != nil {
+		return r, fmt.Errorf("failed to test release %s: %w", rel.Uniq(), err)
+	}
+
+	return r, nil
+}
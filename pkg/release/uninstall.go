@@ -1,20 +1,96 @@
 package release
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/release"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func (rel *config) Uninstall() (*release.UninstallReleaseResponse, error) {
+// UninstallOptions is a set of options for uninstalling release.
+// Release timeout is used if Timeout is not set.
+type UninstallOptions struct {
+	KeepHistory     bool          `yaml:"keep_history,omitempty"`
+	DisableHooks    bool          `yaml:"disable_hooks,omitempty"`
+	Wait            bool          `yaml:"wait,omitempty"`
+	Timeout         time.Duration `yaml:"timeout,omitempty"`
+	DeleteNamespace bool          `yaml:"delete_namespace,omitempty"`
+}
+
+func (rel *config) UninstallOptions() UninstallOptions {
+	return rel.UninstallF
+}
+
+func (rel *config) newUninstall() *action.Uninstall {
 	client := action.NewUninstall(rel.Cfg())
-	client.Timeout = rel.Timeout
 
-	resp, err := client.Run(rel.Name())
+	client.KeepHistory = rel.UninstallF.KeepHistory
+	client.DisableHooks = rel.UninstallF.DisableHooks
+	client.Wait = rel.UninstallF.Wait
+
+	client.Timeout = rel.UninstallF.Timeout
+	if client.Timeout == 0 {
+		client.Timeout = rel.Timeout
+	}
+
+	return client
+}
+
+func (rel *config) Uninstall() (*release.UninstallReleaseResponse, error) {
+	if err := rel.Lifecycle().PreDown.Run(rel.Logger(), "pre_down"); err != nil {
+		return nil, err
+	}
+
+	resp, err := rel.newUninstall().Run(rel.Name())
 	if err != nil {
 		return nil, fmt.Errorf("failed to uninstall release %s: %w", rel.Uniq(), err)
 	}
 
+	if err := rel.Lifecycle().PostDown.Run(rel.Logger(), "post_down"); err != nil {
+		return resp, err
+	}
+
 	return resp, nil
 }
+
+// DeleteNamespace deletes namespace of release.
+// Namespace is kept if some releases or their history (e.g. with keep_history) are still stored there.
+func (rel *config) DeleteNamespace() error {
+	if rel.UninstallF.KeepHistory {
+		rel.Logger().Warnf("keep_history is set, keeping namespace %s with release history", rel.Namespace())
+
+		return nil
+	}
+
+	releases, err := rel.Cfg().Releases.ListReleases()
+	if err != nil {
+		return fmt.Errorf("failed to list releases in namespace %s: %w", rel.Namespace(), err)
+	}
+
+	if len(releases) > 0 {
+		rel.Logger().WithField("left", releases[0].Name).Warnf("namespace %s is not empty, keeping it", rel.Namespace())
+
+		return nil
+	}
+
+	client, err := rel.Cfg().KubernetesClientSet()
+	if err != nil {
+		return fmt.Errorf("failed to get kubernetes client: %w", err)
+	}
+
+	err = client.CoreV1().Namespaces().Delete(context.Background(), rel.Namespace(), metav1.DeleteOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete namespace %s: %w", rel.Namespace(), err)
+	}
+
+	rel.Logger().Infof("🗑 namespace %s deleted", rel.Namespace())
+
+	return nil
+}
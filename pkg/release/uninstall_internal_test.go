@@ -0,0 +1,62 @@
+package release
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+type UninstallTestSuite struct {
+	suite.Suite
+}
+
+// newConfig creates release without kubernetes client, so namespace deletion fails if it is attempted.
+func (s *UninstallTestSuite) newConfig(statuses ...release.Status) *config {
+	s.T().Helper()
+
+	rel := NewConfig()
+	rel.UninstallF.DeleteNamespace = true
+	rel.cfg = &action.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(string, ...interface{}) {},
+	}
+
+	for i, st := range statuses {
+		r := &release.Release{
+			Name:      rel.Name(),
+			Namespace: rel.Namespace(),
+			Version:   i + 1,
+			Info:      &release.Info{Status: st},
+		}
+		s.Require().NoError(rel.cfg.Releases.Create(r))
+	}
+
+	return rel
+}
+
+func (s *UninstallTestSuite) TestDeleteNamespaceKeepHistory() {
+	rel := s.newConfig()
+	rel.UninstallF.KeepHistory = true
+
+	s.Require().NoError(rel.DeleteNamespace())
+}
+
+func (s *UninstallTestSuite) TestDeleteNamespaceUninstalledHistory() {
+	rel := s.newConfig(release.StatusSuperseded, release.StatusUninstalled)
+
+	s.Require().NoError(rel.DeleteNamespace())
+}
+
+func TestUninstallTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UninstallTestSuite))
+}
@@ -3,22 +3,30 @@ package uniqname
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 )
 
-// Separator is a separator between release name and namespace.
+// Separator is a separator between release name, namespace and kube context.
 const Separator = "@"
 
 // ErrValidate is an error for failed uniqname validation.
 var ErrValidate = errors.New("failed to validate uniqname")
 
+// Kube context may contain almost anything, e.g. `arn:aws:eks:eu-west-1:123:cluster/prod` or `admin@kubernetes`.
+var contextRegexp = regexp.MustCompile(`^\S+$`)
+
 // UniqName is an alias for string.
 type UniqName string
 
-// Generate returns uniqname for provided release name and namespace.
-func Generate(name, namespace string) (UniqName, error) {
+// Generate returns uniqname for provided release name, namespace and kube context.
+// Kube context is appended only if it is set, so releases in default context keep `name@namespace` form.
+func Generate(name, namespace, kubeContext string) (UniqName, error) {
 	u := UniqName(fmt.Sprintf("%s%s%s", name, Separator, namespace))
+	if kubeContext != "" {
+		u = UniqName(fmt.Sprintf("%s%s%s", u, Separator, kubeContext))
+	}
 
 	return u, u.Validate()
 }
@@ -45,16 +53,37 @@ func (n UniqName) In(a []UniqName) bool {
 	return false
 }
 
+// Path returns uniqname escaped to be used as a file or directory name.
+// Only kube context can contain characters that need escaping, so uniqnames without it are kept as is.
+func (n UniqName) Path() string {
+	return url.PathEscape(string(n))
+}
+
+// FromPath returns uniqname from file or directory name created with Path.
+func FromPath(p string) (UniqName, error) {
+	s, err := url.PathUnescape(p)
+	if err != nil {
+		return "", fmt.Errorf("failed to unescape uniqname %q: %w", p, err)
+	}
+
+	return UniqName(s), nil
+}
+
 // Validate validates this object.
 func (n UniqName) Validate() error {
-	s := string(n)
-	if len(strings.Split(s, Separator)) != 2 {
+	// Name and namespace cannot contain separator, so everything after them is kube context
+	parts := strings.SplitN(string(n), Separator, 3)
+	if len(parts) < 2 {
 		return ErrValidate
 	}
 
 	r := regexp.MustCompile("[a-z0-9]([-a-z0-9]*[a-z0-9])?" + Separator + "[a-z0-9]([-a-z0-9]*[a-z0-9])?")
 
-	if !r.MatchString(s) {
+	if !r.MatchString(parts[0] + Separator + parts[1]) {
+		return ErrValidate
+	}
+
+	if len(parts) == 3 && !contextRegexp.MatchString(parts[2]) {
 		return ErrValidate
 	}
 
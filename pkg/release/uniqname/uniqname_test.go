@@ -15,6 +15,10 @@ func (s *ValidateTestSuite) TestGood() {
 	data := []string{
 		"my@test",
 		"my-release@test-1",
+		"my@test@prod",
+		"my@test@kind-prod_eu.1",
+		"my@test@arn:aws:eks:eu-west-1:123456789012:cluster/prod",
+		"my@test@kubernetes-admin@kubernetes",
 	}
 
 	for _, d := range data {
@@ -33,6 +37,8 @@ func (s *ValidateTestSuite) TestBad() {
 		"@",
 		"@-",
 		"-@-",
+		"my@test@",
+		"my@test@prod eu",
 	}
 
 	for _, d := range data {
@@ -40,6 +46,27 @@ func (s *ValidateTestSuite) TestBad() {
 	}
 }
 
+func (s *ValidateTestSuite) TestGenerate() {
+	u, err := uniqname.Generate("my", "test", "")
+	s.Require().NoError(err)
+	s.Require().Equal(uniqname.UniqName("my@test"), u)
+
+	u, err = uniqname.Generate("my", "test", "prod")
+	s.Require().NoError(err)
+	s.Require().Equal(uniqname.UniqName("my@test@prod"), u)
+}
+
+func (s *ValidateTestSuite) TestPath() {
+	s.Require().Equal("my@test", uniqname.UniqName("my@test").Path())
+
+	u := uniqname.UniqName("my@test@arn:aws:eks:eu-west-1:123456789012:cluster/prod")
+	s.Require().NotContains(u.Path(), "/")
+
+	res, err := uniqname.FromPath(u.Path())
+	s.Require().NoError(err)
+	s.Require().Equal(u, res)
+}
+
 func TestValidateTestSuite(t *testing.T) {
 	t.Parallel()
 	suite.Run(t, new(ValidateTestSuite))
@@ -16,17 +16,16 @@ func (rel *config) upgrade() (*release.Release, error) {
 		return nil, err
 	}
 
-	// Values
-	valuesFiles := make([]string, 0, len(rel.Values()))
-	for i := range rel.Values() {
-		valuesFiles = append(valuesFiles, rel.Values()[i].Get())
+	vals, err := rel.MergedValues()
+	if err != nil {
+		return nil, err
 	}
 
-	valOpts := &values.Options{ValueFiles: valuesFiles}
-	vals, err := valOpts.MergeValues(getter.All(rel.Helm()))
+	pr, err := rel.PostRenderer()
 	if err != nil {
-		return nil, fmt.Errorf("failed to merge values for release %q: %w", rel.Uniq(), err)
+		return nil, err
 	}
+	client.PostRenderer = pr
 
 	// Install
 	if !rel.isInstalled() {
@@ -34,7 +33,10 @@ func (rel *config) upgrade() (*release.Release, error) {
 			rel.Logger().Debug("🧐 Release does not exist. Installing it now.")
 		}
 
-		r, err := rel.newInstall().Run(ch, vals)
+		install := rel.newInstall()
+		install.PostRenderer = pr
+
+		r, err := install.Run(ch, vals)
 		if err != nil {
 			return nil, fmt.Errorf("failed to install %q: %w", rel.Uniq(), err)
 		}
@@ -50,3 +52,19 @@ func (rel *config) upgrade() (*release.Release, error) {
 
 	return r, nil
 }
+
+// MergedValues merges all values files of release in order.
+func (rel *config) MergedValues() (map[string]interface{}, error) {
+	valuesFiles := make([]string, 0, len(rel.Values()))
+	for i := range rel.Values() {
+		valuesFiles = append(valuesFiles, rel.Values()[i].Get())
+	}
+
+	valOpts := &values.Options{ValueFiles: valuesFiles}
+	vals, err := valOpts.MergeValues(getter.All(rel.Helm()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge values for release %q: %w", rel.Uniq(), err)
+	}
+
+	return vals, nil
+}
@@ -5,21 +5,33 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/helmwave/helmwave/pkg/helper"
 	"github.com/helmwave/helmwave/pkg/release/uniqname"
 	"github.com/helmwave/helmwave/pkg/template"
 	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
 )
 
 // ErrSkipValues is returned when values cannot be used and are skipped.
 var ErrSkipValues = errors.New("values have been skipped")
 
 // ValuesReference is used to match source values file path and temporary.
+// Mapping is a reference only if it has scalar src and no keys other than reference ones (src, dst, decrypt),
+// any other mapping is treated as inline values, they are saved to temporary file during build.
+// Values encrypted with sops are decrypted before templating, see Decrypt.
 type ValuesReference struct {
-	Src string `yaml:"src"`
-	dst string `yaml:"dst"`
+	Src     string                 `yaml:"src"`
+	dst     string                 `yaml:"dst"`
+	Decrypt string                 `yaml:"decrypt,omitempty"`
+	inline  map[string]interface{} `yaml:"-"`
+
+	decrypted bool
 }
 
 // UnmarshalYAML is used to implement Unmarshaler interface of gopkg.in/yaml.v3.
@@ -31,6 +43,14 @@ func (v *ValuesReference) UnmarshalYAML(node *yaml.Node) error {
 			return fmt.Errorf("failed to decode values reference %q from YAML: %w", node.Value, err)
 		}
 	case yaml.MappingNode:
+		if !isReferenceNode(node) {
+			if err := node.Decode(&v.inline); err != nil {
+				return fmt.Errorf("failed to decode inline values from YAML: %w", err)
+			}
+
+			return nil
+		}
+
 		var m map[string]string
 		if err := node.Decode(&m); err != nil {
 			return fmt.Errorf("failed to decode values reference %q from YAML: %w", node.Value, err)
@@ -38,6 +58,8 @@ func (v *ValuesReference) UnmarshalYAML(node *yaml.Node) error {
 
 		v.Src = m["src"]
 		v.dst = m["dst"]
+		v.Decrypt = m["decrypt"]
+		v.decrypted = m["decrypted"] == "true"
 	default:
 		return fmt.Errorf("failed to decode values reference %q from YAML: unknown format", node.Value)
 	}
@@ -48,14 +70,38 @@ func (v *ValuesReference) UnmarshalYAML(node *yaml.Node) error {
 // MarshalYAML is used to implement Marshaler interface of gopkg.in/yaml.v3.
 func (v ValuesReference) MarshalYAML() (interface{}, error) {
 	return struct {
-		Src string
-		Dst string
+		Src       string
+		Dst       string
+		Decrypted bool `yaml:",omitempty"`
 	}{
-		Src: v.Src,
-		Dst: v.dst,
+		Src:       v.Src,
+		Dst:       v.dst,
+		Decrypted: v.decrypted,
 	}, nil
 }
 
+// referenceKeys are keys of values reference mapping.
+var referenceKeys = map[string]bool{"src": true, "dst": true, "decrypt": true, "decrypted": true}
+
+// isReferenceNode checks whether mapping is a values reference and not inline values.
+// Inline values may have src key too, so all keys have to be reference ones with scalar values.
+func isReferenceNode(node *yaml.Node) bool {
+	hasSrc := false
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		k, v := node.Content[i], node.Content[i+1]
+		if !referenceKeys[k.Value] || v.Kind != yaml.ScalarNode {
+			return false
+		}
+
+		if k.Value == "src" {
+			hasSrc = true
+		}
+	}
+
+	return hasSrc
+}
+
 func (v *ValuesReference) isURL() bool {
 	return helper.IsURL(v.Src)
 }
@@ -74,14 +120,24 @@ func (v *ValuesReference) Get() string {
 	return v.dst
 }
 
+// isInline returns true if values are set directly in release config.
+func (v *ValuesReference) isInline() bool {
+	return v.inline != nil
+}
+
 // SetUniq generates unique file path based on provided base directory, release uniqname and sha1 of source path.
+// Inline values use sha1 of their content instead.
 func (v *ValuesReference) SetUniq(dir string, name uniqname.UniqName) *ValuesReference {
 	h := sha1.New() // nolint:gosec
-	h.Write([]byte(v.Src))
+	if v.isInline() {
+		h.Write(helper.Byte(v.inline))
+	} else {
+		h.Write([]byte(v.Src))
+	}
 	hash := h.Sum(nil)
 	s := hex.EncodeToString(hash)
 
-	v.dst = filepath.Join(dir, "values", string(name), s+".yml")
+	v.dst = filepath.Join(dir, "values", name.Path(), s+".yml")
 
 	return v
 }
@@ -92,20 +148,38 @@ func (v *ValuesReference) SetUniq(dir string, name uniqname.UniqName) *ValuesRef
 // }
 
 // SetViaRelease downloads and templates values file.
+// Template data has `.Release`, `.Project` and `.Plan` with all releases, plan may be nil.
 // Returns ErrSkipValues if values cannot be downloaded or doesn't exist in local FS.
-func (v *ValuesReference) SetViaRelease(rel Config, dir, templater string) error {
+func (v *ValuesReference) SetViaRelease(rel Config, dir, templater string, plan *TemplatePlan) error {
 	v.SetUniq(dir, rel.Uniq())
 
 	l := rel.Logger().WithField("values src", v.Src).WithField("values dst", v.dst)
 
 	l.Trace("Building values reference")
 
+	if plan == nil {
+		plan = NewTemplatePlan([]Config{rel}, nil)
+	}
+
 	data := struct {
-		Release Config
+		Release *TemplateRelease
+		Project *TemplateProject
+		Plan    *TemplatePlan
 	}{
-		Release: rel,
+		Release: plan.release(rel),
+		Project: plan.project,
+		Plan:    plan,
+	}
+
+	if v.isInline() {
+		if err := helper.SaveInterface(v.dst, v.inline); err != nil {
+			return fmt.Errorf("failed to save inline values: %w", err)
+		}
+
+		return nil
 	}
 
+	src := v.Src
 	if v.isURL() {
 		err := v.Download()
 		if err != nil {
@@ -114,27 +188,56 @@ func (v *ValuesReference) SetViaRelease(rel Config, dir, templater string) error
 			return ErrSkipValues
 		}
 
-		if err := template.Tpl2yml(v.dst, v.dst, data, templater); err != nil {
+		src = v.dst
+	} else if !helper.IsExists(v.Src) {
+		l.Warn("skipping: local file not found")
+
+		return ErrSkipValues
+	}
+
+	return v.render(src, data, templater)
+}
+
+// render decrypts values file if needed and templates it to destination.
+func (v *ValuesReference) render(src string, data interface{}, templater string) error {
+	content, err := os.ReadFile(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %q values: %w", v.Src, err)
+	}
+
+	encrypted, err := v.needsDecrypt(content)
+	if err != nil {
+		return err
+	}
+
+	if !encrypted {
+		if err := template.Tpl2yml(src, v.dst, data, templater); err != nil {
 			return fmt.Errorf("failed to render %q values: %w", v.Src, err)
 		}
 
 		return nil
-	} else if !helper.IsExists(v.Src) {
-		l.Warn("skipping: local file not found")
+	}
 
-		return ErrSkipValues
+	plain, err := decryptSOPS(content, v.Src)
+	if err != nil {
+		return err
 	}
+	v.decrypted = true
 
-	if err := template.Tpl2yml(v.Src, v.dst, data, templater); err != nil {
+	if err := template.Secret2yml(plain, v.dst, data, templater); err != nil {
 		return fmt.Errorf("failed to render %q values: %w", v.Src, err)
 	}
 
 	return nil
 }
 
-func (rel *config) BuildValues(dir, templater string) error {
+func (rel *config) BuildValues(dir, templater string, plan *TemplatePlan) error {
+	if err := rel.buildOverrides(); err != nil {
+		return err
+	}
+
 	for i := len(rel.Values()) - 1; i >= 0; i-- {
-		err := rel.Values()[i].SetViaRelease(rel, dir, templater)
+		err := rel.Values()[i].SetViaRelease(rel, dir, templater, plan)
 		if errors.Is(ErrSkipValues, err) {
 			rel.ValuesF = append(rel.ValuesF[:i], rel.ValuesF[i+1:]...)
 		} else if err != nil {
@@ -146,3 +249,43 @@ func (rel *config) BuildValues(dir, templater string) error {
 
 	return nil
 }
+
+// buildOverrides converts set, set_string and set_file of release to inline values placed after all other values.
+// Precedence is the same as in helm: values in order < set < set_string < set_file.
+// Overrides are cleared afterwards, so planfile has only values files and doesn't need set_file sources.
+func (rel *config) buildOverrides() error {
+	if len(rel.SetF)+len(rel.SetStringF)+len(rel.SetFileF) == 0 {
+		return nil
+	}
+
+	opts := &values.Options{
+		Values:       overridesList(rel.SetF),
+		StringValues: overridesList(rel.SetStringF),
+		FileValues:   overridesList(rel.SetFileF),
+	}
+
+	vals, err := opts.MergeValues(getter.All(rel.Helm()))
+	if err != nil {
+		return fmt.Errorf("failed to parse set values for release %q: %w", rel.Uniq(), err)
+	}
+
+	rel.ValuesF = append(rel.ValuesF, ValuesReference{inline: vals})
+	rel.SetF, rel.SetStringF, rel.SetFileF = nil, nil, nil
+
+	return nil
+}
+
+// overridesList converts map to sorted list of `key=value` for helm strvals parser.
+// Commas are escaped unless value is a list like `{a,b}`.
+func overridesList(m map[string]string) []string {
+	res := make([]string, 0, len(m))
+	for k, v := range m {
+		if !strings.HasPrefix(v, "{") || !strings.HasSuffix(v, "}") {
+			v = strings.ReplaceAll(v, ",", `\,`)
+		}
+		res = append(res, k+"="+v)
+	}
+	sort.Strings(res)
+
+	return res
+}
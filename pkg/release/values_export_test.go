@@ -1,5 +1,5 @@
 package release
 
 func NewValuesReference(src, dst string) ValuesReference {
-	return ValuesReference{src, dst}
+	return ValuesReference{Src: src, dst: dst}
 }
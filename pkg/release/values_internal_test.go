@@ -0,0 +1,120 @@
+package release
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type ValuesInternalTestSuite struct {
+	suite.Suite
+}
+
+func (s *ValuesInternalTestSuite) TestInline() {
+	src := `
+values:
+- a.yml
+- image:
+    tag: "1.0"
+- src: b.yml
+`
+	c := struct {
+		Values []ValuesReference
+	}{}
+
+	s.Require().NoError(yaml.Unmarshal([]byte(src), &c))
+	s.Require().Len(c.Values, 3)
+
+	s.Require().False(c.Values[0].isInline())
+	s.Require().True(c.Values[1].isInline())
+	s.Require().Equal(map[string]interface{}{"image": map[string]interface{}{"tag": "1.0"}}, c.Values[1].inline)
+	s.Require().False(c.Values[2].isInline())
+	s.Require().Equal("b.yml", c.Values[2].Src)
+}
+
+func (s *ValuesInternalTestSuite) TestInlineWithSrc() {
+	src := `
+values:
+- src: b.yml
+  decrypt: sops
+- src: https://example.com
+  image:
+    tag: "1.0"
+- src:
+    repo: example
+`
+	c := struct {
+		Values []ValuesReference
+	}{}
+
+	s.Require().NoError(yaml.Unmarshal([]byte(src), &c))
+	s.Require().Len(c.Values, 3)
+
+	s.Require().False(c.Values[0].isInline())
+	s.Require().Equal("b.yml", c.Values[0].Src)
+	s.Require().Equal(DecryptSOPS, c.Values[0].Decrypt)
+
+	s.Require().True(c.Values[1].isInline())
+	s.Require().Equal(map[string]interface{}{
+		"src":   "https://example.com",
+		"image": map[string]interface{}{"tag": "1.0"},
+	}, c.Values[1].inline)
+
+	s.Require().True(c.Values[2].isInline())
+}
+
+func (s *ValuesInternalTestSuite) TestOverrides() {
+	tmpDir := s.T().TempDir()
+
+	valuesFile := filepath.Join(tmpDir, "values.yml")
+	s.Require().NoError(os.WriteFile(valuesFile, []byte("image:\n  tag: a\n  repo: b\nreplicas: 1\n"), 0o600))
+
+	certFile := filepath.Join(tmpDir, "cert.pem")
+	s.Require().NoError(os.WriteFile(certFile, []byte("CERT"), 0o600))
+
+	rel := NewConfig()
+	rel.ValuesF = []ValuesReference{
+		{Src: valuesFile},
+		{inline: map[string]interface{}{"image": map[string]interface{}{"repo": "c"}}},
+	}
+	rel.SetF = map[string]string{
+		"image.tag": "d",
+		"replicas":  "3",
+		"hosts":     "{a,b}",
+		"args":      "--a=b,c",
+	}
+	rel.SetStringF = map[string]string{"version": "1.10"}
+	rel.SetFileF = map[string]string{"cert": certFile}
+
+	s.Require().NoError(rel.BuildValues(tmpDir, "sprig", nil))
+	s.Require().Nil(rel.SetF)
+	s.Require().Nil(rel.SetStringF)
+	s.Require().Nil(rel.SetFileF)
+	s.Require().Len(rel.Values(), 3)
+
+	for _, v := range rel.Values() {
+		s.Require().FileExists(v.Get())
+	}
+
+	vals, err := rel.MergedValues()
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]interface{}{
+		"image": map[string]interface{}{
+			"tag":  "d",
+			"repo": "c",
+		},
+		"replicas": float64(3),
+		"args":     "--a=b,c",
+		"hosts":    []interface{}{"a", "b"},
+		"version":  "1.10",
+		"cert":     "CERT",
+	}, vals)
+}
+
+func TestValuesInternalTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ValuesInternalTestSuite))
+}
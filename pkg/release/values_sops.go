@@ -0,0 +1,63 @@
+package release
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mozilla.org/sops/v3/cmd/sops/formats"
+	"go.mozilla.org/sops/v3/decrypt"
+	"gopkg.in/yaml.v3"
+)
+
+// DecryptSOPS is a decrypt method of values encrypted with sops.
+const DecryptSOPS = "sops"
+
+// ErrUnknownDecrypt is returned for unsupported values decrypt method.
+var ErrUnknownDecrypt = errors.New("unknown values decrypt method")
+
+// IsDecrypted returns true if values have been decrypted during build.
+func (v *ValuesReference) IsDecrypted() bool {
+	return v.decrypted
+}
+
+// needsDecrypt checks decrypt method of values. If it is not set, sops metadata is looked up in content.
+func (v *ValuesReference) needsDecrypt(content []byte) (bool, error) {
+	switch v.Decrypt {
+	case DecryptSOPS:
+		return true, nil
+	case "":
+		return isSOPSEncrypted(content), nil
+	default:
+		return false, fmt.Errorf("%w %q, use %q", ErrUnknownDecrypt, v.Decrypt, DecryptSOPS)
+	}
+}
+
+// isSOPSEncrypted returns true if content has sops metadata key with MAC.
+func isSOPSEncrypted(content []byte) bool {
+	meta := struct {
+		SOPS struct {
+			MAC string `yaml:"mac"`
+		} `yaml:"sops"`
+	}{}
+
+	if err := yaml.Unmarshal(content, &meta); err != nil {
+		return false
+	}
+
+	return meta.SOPS.MAC != ""
+}
+
+// decryptSOPS decrypts content with local age or PGP keys. Format is detected by path, YAML is default.
+func decryptSOPS(content []byte, path string) ([]byte, error) {
+	format := formats.Yaml
+	if formats.IsJSONFile(path) {
+		format = formats.Json
+	}
+
+	plain, err := decrypt.DataWithFormat(content, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s with sops: %w", path, err)
+	}
+
+	return plain, nil
+}
@@ -0,0 +1,105 @@
+package release
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/suite"
+	"go.mozilla.org/sops/v3"
+	"go.mozilla.org/sops/v3/aes"
+	sopsage "go.mozilla.org/sops/v3/age"
+	"go.mozilla.org/sops/v3/cmd/sops/common"
+	sopsyaml "go.mozilla.org/sops/v3/stores/yaml"
+	sopsversion "go.mozilla.org/sops/v3/version"
+	"gopkg.in/yaml.v3"
+)
+
+type ValuesSOPSTestSuite struct {
+	suite.Suite
+}
+
+func (s *ValuesSOPSTestSuite) encrypt(plain []byte) []byte {
+	s.T().Helper()
+
+	identity, err := age.GenerateX25519Identity()
+	s.Require().NoError(err)
+	s.T().Setenv(sopsage.SopsAgeKeyEnv, identity.String())
+
+	masterKeys, err := sopsage.MasterKeysFromRecipients(identity.Recipient().String())
+	s.Require().NoError(err)
+
+	store := &sopsyaml.Store{}
+	branches, err := store.LoadPlainFile(plain)
+	s.Require().NoError(err)
+
+	tree := sops.Tree{
+		Branches: branches,
+		Metadata: sops.Metadata{
+			KeyGroups: []sops.KeyGroup{{masterKeys[0]}},
+			Version:   sopsversion.Version,
+		},
+	}
+
+	dataKey, errs := tree.GenerateDataKey()
+	s.Require().Empty(errs)
+	s.Require().NoError(common.EncryptTree(common.EncryptTreeOpts{
+		DataKey: dataKey,
+		Tree:    &tree,
+		Cipher:  aes.NewCipher(),
+	}))
+
+	out, err := store.EmitEncryptedFile(tree)
+	s.Require().NoError(err)
+
+	return out
+}
+
+func (s *ValuesSOPSTestSuite) TestDecrypt() {
+	tmpDir := s.T().TempDir()
+	src := filepath.Join(tmpDir, "secrets.yml")
+	s.Require().NoError(os.WriteFile(src, s.encrypt([]byte("password: qwerty\n")), 0o600))
+
+	rel := NewConfig()
+	rel.ValuesF = []ValuesReference{{Src: src}}
+
+	s.Require().NoError(rel.BuildValues(tmpDir, "sprig", nil))
+	s.Require().True(rel.Values()[0].IsDecrypted())
+
+	vals, err := rel.MergedValues()
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]interface{}{"password": "qwerty"}, vals)
+
+	// planfile keeps that values have been decrypted
+	b, err := yaml.Marshal(rel.Values()[0])
+	s.Require().NoError(err)
+
+	v := ValuesReference{}
+	s.Require().NoError(yaml.Unmarshal(b, &v))
+	s.Require().True(v.IsDecrypted())
+}
+
+func (s *ValuesSOPSTestSuite) TestPlain() {
+	tmpDir := s.T().TempDir()
+	src := filepath.Join(tmpDir, "values.yml")
+	s.Require().NoError(os.WriteFile(src, []byte("a: b\n"), 0o600))
+
+	rel := NewConfig()
+	rel.ValuesF = []ValuesReference{{Src: src}}
+
+	s.Require().NoError(rel.BuildValues(tmpDir, "sprig", nil))
+	s.Require().False(rel.Values()[0].IsDecrypted())
+
+	v := &ValuesReference{Src: src, Decrypt: DecryptSOPS}
+	s.Require().Error(v.SetViaRelease(rel, tmpDir, "sprig", nil))
+
+	v = &ValuesReference{Src: src, Decrypt: "vault"}
+	s.Require().ErrorIs(v.SetViaRelease(rel, tmpDir, "sprig", nil), ErrUnknownDecrypt)
+}
+
+//nolint:paralleltest // uses environment variables for sops keys
+func TestValuesSOPSTestSuite(t *testing.T) {
+	// t.Parallel()
+	suite.Run(t, new(ValuesSOPSTestSuite))
+}
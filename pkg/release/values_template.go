@@ -0,0 +1,71 @@
+package release
+
+// TemplatePlan is available in values templates as `.Plan`.
+type TemplatePlan struct {
+	Releases []*TemplateRelease
+
+	project *TemplateProject
+}
+
+// TemplateProject is available in values templates as `.Project`.
+type TemplateProject struct {
+	Store map[string]interface{}
+}
+
+// TemplateRelease is a release available in values templates as `.Release` or via `.Plan`.
+// It has all methods of Config, but DependsOn resolves to release objects.
+type TemplateRelease struct {
+	Config
+
+	plan *TemplatePlan
+}
+
+// NewTemplatePlan creates plan for values templates from all releases of plan and project store.
+func NewTemplatePlan(releases []Config, store map[string]interface{}) *TemplatePlan {
+	p := &TemplatePlan{
+		Releases: make([]*TemplateRelease, 0, len(releases)),
+		project:  &TemplateProject{Store: store},
+	}
+
+	for _, rel := range releases {
+		p.Releases = append(p.Releases, &TemplateRelease{Config: rel, plan: p})
+	}
+
+	return p
+}
+
+// Release returns release by uniqname or nil if plan does not have it: `{{ (.Plan.Release "redis@db").Store.port }}`.
+func (p *TemplatePlan) Release(name string) *TemplateRelease {
+	for _, rel := range p.Releases {
+		if string(rel.Uniq()) == name {
+			return rel
+		}
+	}
+
+	return nil
+}
+
+// release returns template release for config. Release is added to plan if plan does not have it.
+func (p *TemplatePlan) release(rel Config) *TemplateRelease {
+	if r := p.Release(string(rel.Uniq())); r != nil {
+		return r
+	}
+
+	r := &TemplateRelease{Config: rel, plan: p}
+	p.Releases = append(p.Releases, r)
+
+	return r
+}
+
+// DependsOn returns dependencies of release found in plan.
+func (r *TemplateRelease) DependsOn() []*TemplateRelease {
+	deps := make([]*TemplateRelease, 0, len(r.Config.DependsOn()))
+
+	for _, name := range r.Config.DependsOn() {
+		if dep := r.plan.Release(name); dep != nil {
+			deps = append(deps, dep)
+		}
+	}
+
+	return deps
+}
@@ -0,0 +1,71 @@
+package release
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ValuesTemplateTestSuite struct {
+	suite.Suite
+}
+
+func (s *ValuesTemplateTestSuite) TestPlan() {
+	tmpDir := s.T().TempDir()
+
+	db := NewConfig()
+	db.NameF = "postgres"
+	db.NamespaceF = "db"
+	db.StoreF = map[string]interface{}{"port": 5432}
+
+	app := NewConfig()
+	app.NameF = "app"
+	app.DependsOnF = []string{"postgres@db", "redis@cache"}
+
+	src := filepath.Join(tmpDir, "values.yml")
+	tpl := `
+{{- range .Release.DependsOn }}
+{{ .Name }}: {{ .Name }}.{{ .Namespace }}.svc:{{ .Store.port }}
+{{- end }}
+port: {{ (.Plan.Release "postgres@db").Store.port }}
+releases: {{ len .Plan.Releases }}
+domain: {{ .Project.Store.domain }}
+`
+	s.Require().NoError(os.WriteFile(src, []byte(tpl), 0o600))
+	app.ValuesF = []ValuesReference{{Src: src}}
+
+	s.Require().NoError(app.BuildValues(tmpDir, "sprig", NewTemplatePlan([]Config{db, app}, map[string]interface{}{"domain": "example.com"})))
+
+	vals, err := app.MergedValues()
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]interface{}{
+		"postgres": "postgres.db.svc:5432",
+		"port":     float64(5432),
+		"releases": float64(2),
+		"domain":   "example.com",
+	}, vals)
+}
+
+func (s *ValuesTemplateTestSuite) TestWithoutPlan() {
+	tmpDir := s.T().TempDir()
+
+	rel := NewConfig()
+	rel.StoreF = map[string]interface{}{"a": "b"}
+
+	src := filepath.Join(tmpDir, "values.yml")
+	s.Require().NoError(os.WriteFile(src, []byte("a: {{ .Release.Store.a }}\nreleases: {{ len .Plan.Releases }}\n"), 0o600))
+	rel.ValuesF = []ValuesReference{{Src: src}}
+
+	s.Require().NoError(rel.BuildValues(tmpDir, "sprig", nil))
+
+	vals, err := rel.MergedValues()
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]interface{}{"a": "b", "releases": float64(1)}, vals)
+}
+
+func TestValuesTemplateTestSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ValuesTemplateTestSuite))
+}
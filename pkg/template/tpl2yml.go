@@ -32,34 +32,58 @@ func Tpl2yml(tpl, yml string, data interface{}, templaterName string) error {
 		"to":   yml,
 	}).Trace("Render yml file")
 
-	if data == nil {
-		data = map[string]interface{}{}
-	}
-
 	src, err := os.ReadFile(tpl)
 	if err != nil {
 		return fmt.Errorf("failed to read template file %s: %w", tpl, err)
 	}
 
+	return render2yml(src, yml, data, templaterName, true)
+}
+
+// Secret2yml renders 'src' template that contains secrets to 'yml' file as go template.
+// Unlike Tpl2yml it never logs rendered contents.
+func Secret2yml(src []byte, yml string, data interface{}, templaterName string) error {
+	log.WithField("to", yml).Trace("Render secret yml file")
+
+	return render2yml(src, yml, data, templaterName, false)
+}
+
+// Render renders 'src' string as go template and returns result.
+func Render(src string, data interface{}, templaterName string) (string, error) {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
 	templater, err := getTemplater(templaterName)
 	if err != nil {
-		return err
+		return "", err
 	}
 	log.WithField("template engine", templater.Name()).Debug("Loaded template engine")
 
-	d, err := templater.Render(string(src), data)
+	d, err := templater.Render(src, data)
+	if err != nil {
+		return "", err //nolint:wrapcheck // we control the interface
+	}
+
+	return string(d), nil
+}
+
+func render2yml(src []byte, yml string, data interface{}, templaterName string, trace bool) error {
+	d, err := Render(string(src), data, templaterName)
 	if err != nil {
-		return err //nolint:wrapcheck // we control the interface
+		return err
 	}
 
-	log.Trace(yml, " contents\n", string(d))
+	if trace {
+		log.Trace(yml, " contents\n", d)
+	}
 
 	f, err := helper.CreateFile(yml)
 	if err != nil {
 		return fmt.Errorf("failed to create destination file %s: %w", yml, err)
 	}
 
-	_, err = f.Write(d)
+	_, err = f.WriteString(d)
 	if err != nil {
 		return fmt.Errorf("failed to write to destination file %s: %w", yml, err)
 	}